@@ -0,0 +1,80 @@
+# bkyb Makefile
+#
+# bsg - Boseji's Security and Privacy Utilities
+#
+# Sources
+# -------
+# https://github.com/boseji/bsg
+#
+# License
+# -------
+#
+#   bsg - Boseji's Security and Privacy Utilities
+#   Copyright (C) 2025-2026 by Abhijit Bose (aka. Boseji)
+#
+#   This program is free software: you can redistribute it and/or modify
+#   it under the terms of the GNU General Public License version 2 only
+#   as published by the Free Software Foundation.
+#
+#   This program is distributed in the hope that it will be useful,
+#   but WITHOUT ANY WARRANTY; without even the implied warranty of
+#   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. 
+#
+#   You should have received a copy of the GNU General Public License
+#   along with this program. If not, see <https://www.gnu.org/licenses/>.
+#
+#  SPDX-License-Identifier: GPL-2.0-only
+#  Full Name: GNU General Public License v2.0 only
+#  Please visit <https://spdx.org/licenses/GPL-2.0-only.html> for details.
+#
+
+# Application name.
+BKYB_APP_NAME	:= bkyb
+
+# Output directory for binaries.
+BKYB_BINDIR	:= build
+
+# Source dir containing main.go
+BKYB_SRCDIR	:= cmd/$(BKYB_APP_NAME)
+
+# The kyb package does the actual typing.
+BKYB_DEPS	:= $(wildcard kyb/*.go)
+
+# targets
+.PHONY: bkyb clean_bkyb
+
+bkyb: $(BKYB_BINDIR) \
+		$(BKYB_BINDIR)/$(BKYB_APP_NAME)-windows.exe \
+		$(BKYB_BINDIR)/$(BKYB_APP_NAME)-linux-amd64 \
+		$(BKYB_BINDIR)/$(BKYB_APP_NAME)-linux-arm \
+		$(BKYB_BINDIR)/$(BKYB_APP_NAME)-linux-arm64 \
+		$(BKYB_BINDIR)/$(BKYB_APP_NAME)-darwin-amd64 \
+		$(BKYB_BINDIR)/$(BKYB_APP_NAME)-darwin-arm64
+
+# ensure output dir exists (order-only), unless btotp.mk already does
+ifneq ($(BKYB_BINDIR),$(BTOTP_BINDIR))
+$(BKYB_BINDIR):
+	mkdir -p $@
+endif
+
+# per-platform builds
+$(BKYB_BINDIR)/$(BKYB_APP_NAME)-windows.exe: $(BKYB_SRCDIR)/main.go $(BKYB_DEPS) | $(BKYB_BINDIR)
+	GOOS=windows GOARCH=amd64 go build -o $@ ./$(BKYB_SRCDIR)
+
+$(BKYB_BINDIR)/$(BKYB_APP_NAME)-linux-amd64: $(BKYB_SRCDIR)/main.go $(BKYB_DEPS) | $(BKYB_BINDIR)
+	GOOS=linux   GOARCH=amd64 go build -o $@ ./$(BKYB_SRCDIR)
+
+$(BKYB_BINDIR)/$(BKYB_APP_NAME)-linux-arm: $(BKYB_SRCDIR)/main.go $(BKYB_DEPS) | $(BKYB_BINDIR)
+	GOOS=linux   GOARCH=arm   go build -o $@ ./$(BKYB_SRCDIR)
+
+$(BKYB_BINDIR)/$(BKYB_APP_NAME)-linux-arm64: $(BKYB_SRCDIR)/main.go $(BKYB_DEPS) | $(BKYB_BINDIR)
+	GOOS=linux   GOARCH=arm64 go build -o $@ ./$(BKYB_SRCDIR)
+
+$(BKYB_BINDIR)/$(BKYB_APP_NAME)-darwin-amd64: $(BKYB_SRCDIR)/main.go $(BKYB_DEPS) | $(BKYB_BINDIR)
+	GOOS=darwin  GOARCH=amd64 go build -o $@ ./$(BKYB_SRCDIR)
+
+$(BKYB_BINDIR)/$(BKYB_APP_NAME)-darwin-arm64: $(BKYB_SRCDIR)/main.go $(BKYB_DEPS) | $(BKYB_BINDIR)
+	GOOS=darwin  GOARCH=arm64 go build -o $@ ./$(BKYB_SRCDIR)
+
+clean_bkyb:
+	rm -rf $(BKYB_BINDIR)/$(BKYB_APP_NAME)-*
@@ -0,0 +1,366 @@
+// main.go - Part of the `bkyb` Utility
+//
+//     ॐ भूर्भुवः स्वः
+//     तत्स॑वि॒तुर्वरे॑ण्यं॒
+//    भर्गो॑ दे॒वस्य॑ धीमहि।
+//   धियो॒ यो नः॑ प्रचो॒दया॑त्॥
+//
+//
+//  बी.स.जी - बोसजी के द्वारा रचित सुरक्षा एवं गोपनीयता हेतु तन्त्राक्ष्।
+// ================================================
+//
+// एक सुरक्षा एवं गोपनीयता केंद्रित तंत्राक्षों का संकलन।
+//
+// एक रचनात्मक भारतीय उत्पाद ।
+//
+// bsg - Boseji's Security and Privacy Utilities
+//
+// A collection of Security and Privacy utilities and some notes for help.
+//
+// This is **Golang** package collection as well as few utility
+// command line programs.
+//
+// Sources
+// -------
+// https://github.com/boseji/bsg
+//
+// License
+// -------
+//
+//   bsg - Boseji's Security and Privacy Utilities.
+//   Copyright (C) 2025-2026 by Abhijit Bose (aka. Boseji)
+//
+//   This program is free software: you can redistribute it and/or modify
+//   it under the terms of the GNU General Public License version 2 only
+//   as published by the Free Software Foundation.
+//
+//   This program is distributed in the hope that it will be useful,
+//   but WITHOUT ANY WARRANTY; without even the implied warranty of
+//   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+//
+//   You should have received a copy of the GNU General Public License
+//   along with this program. If not, see <https://www.gnu.org/licenses/>.
+//
+//  SPDX-License-Identifier: GPL-2.0-only
+//  Full Name: GNU General Public License v2.0 only
+//  Please visit <https://spdx.org/licenses/GPL-2.0-only.html> for details.
+//
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/boseji/bsg/kyb"
+)
+
+// keyList collects the repeated -key flags
+type keyList []string
+
+func (k *keyList) String() string { return strings.Join(*k, " ") }
+
+func (k *keyList) Set(v string) error {
+	if err := kyb.ValidateKey(v); err != nil {
+		return err
+	}
+	*k = append(*k, v)
+	return nil
+}
+
+// config holds the parsed command line
+type config struct {
+	file        string
+	env         string
+	sequence    string
+	keys        keyList
+	backend     string
+	title       string
+	class       string
+	delay       time.Duration
+	wait        time.Duration
+	keepNewline bool
+	dryRun      bool
+	list        bool
+}
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	code := run(ctx, os.Args[1:], os.Stdin, os.Stdout, os.Stderr)
+	stop()
+	os.Exit(code)
+}
+
+// run executes bkyb with the given arguments and returns the exit code:
+// 0 on success, 1 on failure and 2 for a bad command line
+func run(ctx context.Context, args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	var cfg config
+	fs := flag.NewFlagSet("bkyb", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+
+	// Where the secret text comes from, never the command line.
+	fs.StringVar(&cfg.file, "file", "", "read the text from a file instead of stdin")
+	fs.StringVar(&cfg.file, "f", "", "read the text from a file (shorthand)")
+	fs.StringVar(&cfg.env, "env", "", "read the text from an environment variable")
+	fs.StringVar(&cfg.env, "e", "", "read the text from an environment variable (shorthand)")
+
+	// What to do with it.
+	fs.StringVar(&cfg.sequence, "seq", "", "run an auto-type sequence, fields come from a JSON object on the input")
+	fs.Var(&cfg.keys, "key", "press a key or chord such as ctrl+v, repeat for more")
+	fs.BoolVar(&cfg.list, "list", false, "list the keyboard backends and exit")
+
+	// How to do it.
+	fs.StringVar(&cfg.backend, "backend", "", "force a keyboard backend instead of the automatic choice")
+	fs.StringVar(&cfg.title, "title", "", "focus the window whose title matches this regular expression")
+	fs.StringVar(&cfg.class, "class", "", "focus the window whose class matches this regular expression")
+	fs.DurationVar(&cfg.delay, "delay", 0, "delay between keystrokes, e.g. 20ms")
+	fs.DurationVar(&cfg.wait, "wait", 0, "wait before typing, e.g. 300ms to let go of a hotkey")
+	fs.BoolVar(&cfg.keepNewline, "keep-newline", false, "keep a trailing newline of the input")
+	fs.BoolVar(&cfg.dryRun, "dry-run", false, "print what would be typed, without field values, and send nothing")
+
+	var showHelp bool
+	fs.BoolVar(&showHelp, "help", false, "display help")
+	fs.BoolVar(&showHelp, "h", false, "display help (shorthand)")
+
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, "Boseji's keyboard auto-type tool v0.1")
+		fmt.Fprintf(stderr, "Usage: bkyb [flags] < text\n")
+		fmt.Fprintf(stderr, "       bkyb -seq '{USERNAME}{TAB}{PASSWORD}{ENTER}' < fields.json\n")
+		fmt.Fprintf(stderr, "       bkyb -key ctrl+l -key enter\n")
+		fs.PrintDefaults()
+	}
+
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return 0
+		}
+		return 2
+	}
+	if showHelp {
+		fs.Usage()
+		return 0
+	}
+	if fs.NArg() > 0 {
+		fmt.Fprintln(stderr, "Error: text is never taken from the command line, use stdin, -file or -env")
+		return 2
+	}
+	if cfg.file != "" && cfg.env != "" {
+		fmt.Fprintln(stderr, "Error: use only one of -file and -env")
+		return 2
+	}
+	if cfg.sequence != "" && len(cfg.keys) > 0 {
+		fmt.Fprintln(stderr, "Error: use only one of -seq and -key")
+		return 2
+	}
+
+	if cfg.backend != "" {
+		if err := kyb.SetBackend(cfg.backend); err != nil {
+			fmt.Fprintf(stderr, "Error: %v\n", err)
+			return 2
+		}
+	}
+	if cfg.list {
+		listBackends(stdout)
+		return 0
+	}
+
+	if err := execute(ctx, &cfg, stdin, stdout); err != nil {
+		fmt.Fprintf(stderr, "Error: %v\n", err)
+		return 1
+	}
+	return 0
+}
+
+// listBackends prints every backend in priority order, marking the one
+// in use with * and those that cannot run here as unavailable
+func listBackends(w io.Writer) {
+	active := kyb.CurrentBackend()
+	for _, b := range kyb.Backends() {
+		mark, state := " ", "available"
+		if b.Name() == active {
+			mark = "*"
+		}
+		if !b.Available() {
+			state = "unavailable"
+		}
+		fmt.Fprintf(w, "%s %-16s %s\n", mark, b.Name(), state)
+	}
+}
+
+// execute reads the input, if the mode needs one, and types it
+func execute(ctx context.Context, cfg *config, stdin io.Reader, stdout io.Writer) error {
+	match, err := cfg.window()
+	if err != nil {
+		return err
+	}
+
+	var seq *kyb.AutoType
+	if cfg.sequence != "" {
+		if seq, err = kyb.ParseAutoType(cfg.sequence); err != nil {
+			return err
+		}
+	}
+
+	// Keys alone need no input.
+	var data []byte
+	if len(cfg.keys) == 0 {
+		if data, err = cfg.input(stdin); err != nil {
+			return err
+		}
+		defer clear(data)
+	}
+
+	// Field values are Go strings, which cannot be wiped; only the raw
+	// input in data is cleared.
+	var fields map[string]string
+	if seq != nil {
+		if err = json.Unmarshal(data, &fields); err != nil {
+			return fmt.Errorf("parsing fields: %w", err)
+		}
+	}
+
+	if cfg.dryRun {
+		return dryRun(cfg, match, seq, fields, data, stdout)
+	}
+
+	kyb.SetDelay(cfg.delay)
+	if err = sleep(ctx, cfg.wait); err != nil {
+		return err
+	}
+
+	var target *kyb.Target
+	if match != nil {
+		if target, err = kyb.Focus(*match); err != nil {
+			return err
+		}
+	}
+
+	switch {
+	case seq != nil && target != nil:
+		return target.RunAutoType(seq, fields)
+	case seq != nil:
+		return seq.Run(fields)
+	case len(cfg.keys) > 0:
+		for _, k := range cfg.keys {
+			if target != nil {
+				err = target.KeyPress(k)
+			} else {
+				err = kyb.KeyPress(k)
+			}
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	// The typing API takes a string, so the text necessarily lives in an
+	// immutable Go string copy that clearing data does not reach.
+	case target != nil:
+		return target.TypeWithOptions(ctx, string(data))
+	}
+	return kyb.TypeWithOptions(ctx, string(data))
+}
+
+// dryRun prints the steps execute would take. Typed text is shown by
+// its length only.
+func dryRun(cfg *config, match *kyb.WindowMatch, seq *kyb.AutoType,
+	fields map[string]string, data []byte, w io.Writer) error {
+	if cfg.wait > 0 {
+		fmt.Fprintf(w, "sleep %s\n", cfg.wait)
+	}
+	if match != nil {
+		fmt.Fprintf(w, "focus title=%q class=%q\n", cfg.title, cfg.class)
+	}
+
+	switch {
+	case seq != nil:
+		return seq.DryRun(w, fields)
+	case len(cfg.keys) > 0:
+		for _, k := range cfg.keys {
+			fmt.Fprintf(w, "press %q\n", k)
+		}
+	default:
+		fmt.Fprintf(w, "type %d characters\n", utf8.RuneCount(data))
+	}
+	return nil
+}
+
+// window compiles -title and -class into a match, nil if neither is set
+func (cfg *config) window() (*kyb.WindowMatch, error) {
+	if cfg.title == "" && cfg.class == "" {
+		return nil, nil
+	}
+
+	var m kyb.WindowMatch
+	var err error
+	if cfg.title != "" {
+		if m.Title, err = regexp.Compile(cfg.title); err != nil {
+			return nil, fmt.Errorf("bad -title: %w", err)
+		}
+	}
+	if cfg.class != "" {
+		if m.Class, err = regexp.Compile(cfg.class); err != nil {
+			return nil, fmt.Errorf("bad -class: %w", err)
+		}
+	}
+	return &m, nil
+}
+
+// input reads the text from -env, -file or stdin. A single trailing
+// newline, as left by echo or most password managers, is dropped unless
+// -keep-newline is given.
+func (cfg *config) input(stdin io.Reader) ([]byte, error) {
+	var data []byte
+	var err error
+
+	switch {
+	case cfg.env != "":
+		v, ok := os.LookupEnv(cfg.env)
+		if !ok {
+			return nil, fmt.Errorf("environment variable %s is not set", cfg.env)
+		}
+		data = []byte(v)
+	case cfg.file != "":
+		if data, err = os.ReadFile(cfg.file); err != nil {
+			return nil, err
+		}
+	default:
+		if data, err = io.ReadAll(stdin); err != nil {
+			return nil, fmt.Errorf("reading stdin: %w", err)
+		}
+	}
+
+	if !cfg.keepNewline {
+		if n := len(data); n > 0 && data[n-1] == '\n' {
+			data = data[:n-1]
+			if n := len(data); n > 0 && data[n-1] == '\r' {
+				data = data[:n-1]
+			}
+		}
+	}
+	return data, nil
+}
+
+// sleep waits for d unless ctx is cancelled first
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return nil
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
@@ -0,0 +1,205 @@
+// main_test.go - Test Program `bkyb` Utility
+//
+//     ॐ भूर्भुवः स्वः
+//     तत्स॑वि॒तुर्वरे॑ण्यं॒
+//    भर्गो॑ दे॒वस्य॑ धीमहि।
+//   धियो॒ यो नः॑ प्रचो॒दया॑त्॥
+//
+//
+//  बी.स.जी - बोसजी के द्वारा रचित सुरक्षा एवं गोपनीयता हेतु तन्त्राक्ष्।
+// ================================================
+//
+// एक सुरक्षा एवं गोपनीयता केंद्रित तंत्राक्षों का संकलन।
+//
+// एक रचनात्मक भारतीय उत्पाद ।
+//
+// bsg - Boseji's Security and Privacy Utilities
+//
+// A collection of Security and Privacy utilities and some notes for help.
+//
+// This is **Golang** package collection as well as few utility
+// command line programs.
+//
+// Sources
+// -------
+// https://github.com/boseji/bsg
+//
+// License
+// -------
+//
+//   bsg - Boseji's Security and Privacy Utilities.
+//   Copyright (C) 2025-2026 by Abhijit Bose (aka. Boseji)
+//
+//   This program is free software: you can redistribute it and/or modify
+//   it under the terms of the GNU General Public License version 2 only
+//   as published by the Free Software Foundation.
+//
+//   This program is distributed in the hope that it will be useful,
+//   but WITHOUT ANY WARRANTY; without even the implied warranty of
+//   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+//
+//   You should have received a copy of the GNU General Public License
+//   along with this program. If not, see <https://www.gnu.org/licenses/>.
+//
+//  SPDX-License-Identifier: GPL-2.0-only
+//  Full Name: GNU General Public License v2.0 only
+//  Please visit <https://spdx.org/licenses/GPL-2.0-only.html> for details.
+//
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/boseji/bsg/kyb"
+)
+
+// record routes kyb through a Recorder for the test
+func record(t *testing.T) *kyb.Recorder {
+	t.Helper()
+	t.Setenv(kyb.BackendEnv, "")
+	rec := kyb.NewRecorder()
+	kyb.Register(rec, 100)
+	t.Cleanup(func() {
+		kyb.Unregister(rec.Name())
+		kyb.SetBackend("")
+		kyb.SetDelay(0)
+	})
+	return rec
+}
+
+// bkyb runs the command and returns the exit code, stdout and stderr
+func bkyb(stdin string, args ...string) (int, string, string) {
+	var out, errOut bytes.Buffer
+	code := run(context.Background(), args, strings.NewReader(stdin), &out, &errOut)
+	return code, out.String(), errOut.String()
+}
+
+func events(rec *kyb.Recorder) []string {
+	var got []string
+	for _, e := range rec.Events() {
+		got = append(got, e.String())
+	}
+	return got
+}
+
+func TestRun(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "secret.txt")
+	if err := os.WriteFile(file, []byte("from file\r\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("BKYB_TEST_SECRET", "from env")
+
+	tests := []struct {
+		name  string
+		stdin string
+		args  []string
+		want  []string
+	}{
+		{"stdin", "s3cret!\n", nil, []string{`type "s3cret!"`}},
+		{"keep newline", "line\n", []string{"-keep-newline"}, []string{`type "line\n"`}},
+		{"file", "", []string{"-f", file}, []string{`type "from file"`}},
+		{"env", "", []string{"-env", "BKYB_TEST_SECRET"}, []string{`type "from env"`}},
+		{"delay", "ab", []string{"-delay", "5ms"}, []string{`type "ab" delay=5ms`}},
+		{"keys", "", []string{"-key", "ctrl+a", "-key", "Enter"},
+			[]string{`press "ctrl+a"`, `press "Enter"`}},
+		{"sequence", `{"username":"bose","Password":"p{a}ss"}`,
+			[]string{"-seq", "{USERNAME}{TAB}{PASSWORD}{ENTER}"},
+			[]string{`type "bose"`, `press "tab"`, `type "p{a}ss"`, `press "enter"`}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			rec := record(t)
+			code, _, errOut := bkyb(tc.stdin, tc.args...)
+			if code != 0 {
+				t.Fatalf("exit %d: %s", code, errOut)
+			}
+			if got := events(rec); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("events = %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestRunErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		stdin string
+		args  []string
+		code  int
+	}{
+		{"text in argv", "", []string{"hunter2"}, 2},
+		{"file and env", "", []string{"-f", "x", "-env", "X"}, 2},
+		{"seq and key", "", []string{"-seq", "a", "-key", "a"}, 2},
+		{"unknown key", "", []string{"-key", "hyper+q"}, 2},
+		{"unknown backend", "", []string{"-backend", "nope"}, 2},
+		{"unset env", "", []string{"-env", "BKYB_TEST_UNSET"}, 1},
+		{"bad sequence", "{}", []string{"-seq", "{TAB"}, 1},
+		{"bad fields", "user", []string{"-seq", "{USERNAME}"}, 1},
+		{"missing field", "{}", []string{"-seq", "{USERNAME}"}, 1},
+		{"bad title", "", []string{"-title", "("}, 1},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			rec := record(t)
+			code, _, errOut := bkyb(tc.stdin, tc.args...)
+			if code != tc.code {
+				t.Errorf("exit %d, want %d: %s", code, tc.code, errOut)
+			}
+			if strings.Contains(errOut, "hunter2") {
+				t.Errorf("stderr echoes the text: %s", errOut)
+			}
+			if len(rec.Events()) != 0 {
+				t.Errorf("sent %v", events(rec))
+			}
+		})
+	}
+}
+
+func TestRunDryRun(t *testing.T) {
+	rec := record(t)
+
+	code, out, errOut := bkyb(`{"username":"bose","password":"hunter2"}`,
+		"-dry-run", "-wait", "1s", "-title", "Login",
+		"-seq", "{USERNAME}{TAB}{PASSWORD}{ENTER}")
+	if code != 0 {
+		t.Fatalf("exit %d: %s", code, errOut)
+	}
+	want := "sleep 1s\n" +
+		"focus title=\"Login\" class=\"\"\n" +
+		"type {USERNAME}\npress \"tab\"\ntype {PASSWORD}\npress \"enter\"\n"
+	if out != want {
+		t.Errorf("dry run:\n%s\nwant:\n%s", out, want)
+	}
+
+	code, out, _ = bkyb("hunter2\n", "-dry-run")
+	if code != 0 || out != "type 7 characters\n" {
+		t.Errorf("dry run text = %d %q", code, out)
+	}
+	if len(rec.Events()) != 0 {
+		t.Errorf("dry run sent %v", events(rec))
+	}
+}
+
+func TestRunList(t *testing.T) {
+	rec := record(t)
+	rec.SetAvailable(true)
+
+	code, out, _ := bkyb("", "-list")
+	if code != 0 {
+		t.Fatalf("exit %d", code)
+	}
+	lines := strings.Split(out, "\n")
+	if !strings.HasPrefix(lines[0], "* recorder") {
+		t.Errorf("first backend = %q, want the active recorder", lines[0])
+	}
+	if len(lines) != len(kyb.Backends())+1 {
+		t.Errorf("listed %d lines for %d backends", len(lines)-1, len(kyb.Backends()))
+	}
+}
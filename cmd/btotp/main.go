@@ -57,6 +57,7 @@ import (
 	// Import embed package.
 	_ "embed"
 
+	"github.com/boseji/bsg/gen"
 	"github.com/boseji/bsg/totp"
 )
 
@@ -64,9 +65,10 @@ import (
 var embeddedSecrets []byte
 
 // SecretEntry represents an entry in the JSON file.
+// The secret is kept in a gen.Secret so it can be wiped after use.
 type SecretEntry struct {
-	Name   string `json:"name"`
-	Secret string `json:"secret"`
+	Name   string      `json:"name"`
+	Secret *gen.Secret `json:"secret"`
 }
 
 func main() {
@@ -106,6 +108,8 @@ func main() {
 			fmt.Fprintf(os.Stderr, "Error reading file %s: %v\n", secretsFile, err)
 			os.Exit(1)
 		}
+		// Wipe the file contents once parsed.
+		defer clear(data)
 	} else {
 		// Fallback to embedded secrets.
 		data = embeddedSecrets
@@ -122,7 +126,16 @@ func main() {
 
 	// Generate and display the TOTP for each secret.
 	for _, entry := range secrets {
-		totp := totp.GenerateTOTP(entry.Secret)
-		fmt.Printf("Name: %-10s TOTP: %s\n", entry.Name, totp)
+		if entry.Secret == nil {
+			fmt.Fprintf(os.Stderr, "Error: no secret for %s\n", entry.Name)
+			continue
+		}
+		code, err := totp.GenerateSecret(entry.Secret)
+		entry.Secret.Destroy()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error generating TOTP for %s: %v\n", entry.Name, err)
+			continue
+		}
+		fmt.Printf("Name: %-10s TOTP: %s\n", entry.Name, code)
 	}
 }
@@ -0,0 +1,296 @@
+// crypt.go - Part of the `crypt` Package
+//
+//     ॐ भूर्भुवः स्वः
+//     तत्स॑वि॒तुर्वरे॑ण्यं॒
+//    भर्गो॑ दे॒वस्य॑ धीमहि।
+//   धियो॒ यो नः॑ प्रचो॒दया॑त्॥
+//
+//
+//  बी.स.जी - बोसजी के द्वारा रचित सुरक्षा एवं गोपनीयता हेतु तन्त्राक्ष्।
+// ================================================
+//
+// एक सुरक्षा एवं गोपनीयता केंद्रित तंत्राक्षों का संकलन।
+//
+// एक रचनात्मक भारतीय उत्पाद ।
+//
+// bsg - Boseji's Security and Privacy Utilities
+//
+// A collection of Security and Privacy utilities and some notes for help.
+//
+// This is **Golang** package collection as well as few utility
+// command line programs.
+//
+// Sources
+// -------
+// https://github.com/boseji/bsg
+//
+// License
+// -------
+//
+//   bsg - Boseji's Security and Privacy Utilities.
+//   Copyright (C) 2025-2026 by Abhijit Bose (aka. Boseji)
+//
+//   This program is free software: you can redistribute it and/or modify
+//   it under the terms of the GNU General Public License version 2 only
+//   as published by the Free Software Foundation.
+//
+//   This program is distributed in the hope that it will be useful,
+//   but WITHOUT ANY WARRANTY; without even the implied warranty of
+//   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+//
+//   You should have received a copy of the GNU General Public License
+//   along with this program. If not, see <https://www.gnu.org/licenses/>.
+//
+//  SPDX-License-Identifier: GPL-2.0-only
+//  Full Name: GNU General Public License v2.0 only
+//  Please visit <https://spdx.org/licenses/GPL-2.0-only.html> for details.
+//
+
+// Package crypt encrypts streams of any size for one or more recipients.
+//
+// A random 32 byte file key encrypts the payload in 64 KiB chunks with
+// ChaCha20-Poly1305 using the STREAM construction: each chunk nonce holds
+// a counter and a final-chunk flag, so chunks that are dropped, reordered
+// or cut off are detected. The file key is wrapped for every recipient,
+// either a passphrase through scrypt or an X25519 public key, and stored
+// in a versioned header that is authenticated with an HMAC under the
+// file key.
+//
+// The encrypted format is:
+//
+//	"BSGENC" version(1) count(1)
+//	count x ( type(1) length(2) body )
+//	nonce(16) mac(32)
+//	chunks ...
+package crypt
+
+import (
+	"bytes"
+	"crypto/hkdf"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+)
+
+const (
+	// Version is the format version written by Encrypt.
+	Version = 1
+
+	// ChunkSize is the plaintext size of every chunk but the last.
+	ChunkSize = 64 * 1024
+
+	fileKeySize = 32
+	nonceSize   = 16
+	macSize     = 32
+)
+
+// magic starts every encrypted stream.
+var magic = []byte("BSGENC")
+
+var (
+	ErrNoRecipients      = errors.New("crypt: no recipients")
+	ErrNoIdentityMatch   = errors.New("crypt: no identity matched any recipient")
+	ErrUnsupported       = errors.New("crypt: unsupported format version")
+	ErrInvalidHeader     = errors.New("crypt: invalid header")
+	ErrScryptNotAlone    = errors.New("crypt: a passphrase must be the only recipient")
+	ErrTruncated         = errors.New("crypt: stream truncated")
+	ErrAuthentication    = errors.New("crypt: chunk failed authentication")
+	ErrTrailingData      = errors.New("crypt: data after final chunk")
+	ErrInvalidPassphrase = errors.New("crypt: empty passphrase")
+	ErrWrongPassphrase   = errors.New("crypt: incorrect passphrase")
+	ErrWorkFactor        = errors.New("crypt: scrypt work factor out of range")
+	ErrInvalidKey        = errors.New("crypt: invalid key")
+)
+
+// stanza is one wrapped copy of the file key.
+type stanza struct {
+	Type byte
+	Body []byte
+}
+
+// header is everything that precedes the payload.
+type header struct {
+	Stanzas []stanza
+	Nonce   [nonceSize]byte
+	MAC     []byte
+}
+
+// marshalNoMAC encodes the header up to the MAC.
+func (h *header) marshalNoMAC() []byte {
+	var b bytes.Buffer
+	b.Write(magic)
+	b.WriteByte(Version)
+	b.WriteByte(byte(len(h.Stanzas)))
+	for _, s := range h.Stanzas {
+		b.WriteByte(s.Type)
+		binary.Write(&b, binary.BigEndian, uint16(len(s.Body)))
+		b.Write(s.Body)
+	}
+	b.Write(h.Nonce[:])
+	return b.Bytes()
+}
+
+// readHeader parses the header from r, returning it together with the
+// bytes the MAC covers.
+func readHeader(r io.Reader) (*header, []byte, error) {
+	var raw bytes.Buffer
+	tr := io.TeeReader(r, &raw)
+
+	fixed := make([]byte, len(magic)+2)
+	if _, err := io.ReadFull(tr, fixed); err != nil {
+		return nil, nil, fmt.Errorf("%w: %v", ErrInvalidHeader, err)
+	}
+	if !bytes.Equal(fixed[:len(magic)], magic) {
+		return nil, nil, fmt.Errorf("%w: not an encrypted stream", ErrInvalidHeader)
+	}
+	if v := fixed[len(magic)]; v != Version {
+		return nil, nil, fmt.Errorf("%w: %d", ErrUnsupported, v)
+	}
+	count := int(fixed[len(magic)+1])
+	if count == 0 {
+		return nil, nil, fmt.Errorf("%w: no recipients", ErrInvalidHeader)
+	}
+
+	h := &header{}
+	for i := 0; i < count; i++ {
+		var t [3]byte
+		if _, err := io.ReadFull(tr, t[:]); err != nil {
+			return nil, nil, fmt.Errorf("%w: %v", ErrInvalidHeader, err)
+		}
+		body := make([]byte, binary.BigEndian.Uint16(t[1:]))
+		if _, err := io.ReadFull(tr, body); err != nil {
+			return nil, nil, fmt.Errorf("%w: %v", ErrInvalidHeader, err)
+		}
+		h.Stanzas = append(h.Stanzas, stanza{Type: t[0], Body: body})
+	}
+	if _, err := io.ReadFull(tr, h.Nonce[:]); err != nil {
+		return nil, nil, fmt.Errorf("%w: %v", ErrInvalidHeader, err)
+	}
+	covered := bytes.Clone(raw.Bytes())
+	h.MAC = make([]byte, macSize)
+	if _, err := io.ReadFull(r, h.MAC); err != nil {
+		return nil, nil, fmt.Errorf("%w: %v", ErrInvalidHeader, err)
+	}
+	return h, covered, nil
+}
+
+// headerMAC authenticates the encoded header under the file key.
+func headerMAC(fileKey, covered []byte) ([]byte, error) {
+	key, err := hkdf.Key(sha256.New, fileKey, nil, "bsg/crypt header", 32)
+	if err != nil {
+		return nil, err
+	}
+	m := hmac.New(sha256.New, key)
+	m.Write(covered)
+	return m.Sum(nil), nil
+}
+
+// payloadKey derives the chunk key from the file key and header nonce.
+func payloadKey(fileKey []byte, nonce [nonceSize]byte) ([]byte, error) {
+	return hkdf.Key(sha256.New, fileKey, nonce[:], "bsg/crypt payload", 32)
+}
+
+// Encrypt writes the header for the recipients to w and returns a writer
+// that encrypts everything written to it. Close must be called to write
+// the final chunk; it does not close w.
+func Encrypt(w io.Writer, recipients ...Recipient) (io.WriteCloser, error) {
+	if len(recipients) == 0 {
+		return nil, ErrNoRecipients
+	}
+	if len(recipients) > 255 {
+		return nil, fmt.Errorf("crypt: %d recipients, at most 255", len(recipients))
+	}
+	for _, r := range recipients {
+		if _, ok := r.(*ScryptRecipient); ok && len(recipients) > 1 {
+			return nil, ErrScryptNotAlone
+		}
+	}
+
+	fileKey := make([]byte, fileKeySize)
+	if _, err := rand.Read(fileKey); err != nil {
+		return nil, err
+	}
+	defer clear(fileKey)
+
+	h := &header{}
+	for _, r := range recipients {
+		s, err := r.wrap(fileKey)
+		if err != nil {
+			return nil, err
+		}
+		h.Stanzas = append(h.Stanzas, s)
+	}
+	if _, err := rand.Read(h.Nonce[:]); err != nil {
+		return nil, err
+	}
+	covered := h.marshalNoMAC()
+	mac, err := headerMAC(fileKey, covered)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := w.Write(append(covered, mac...)); err != nil {
+		return nil, err
+	}
+
+	key, err := payloadKey(fileKey, h.Nonce)
+	if err != nil {
+		return nil, err
+	}
+	return newWriter(key, w)
+}
+
+// Decrypt reads the header from r, unwraps the file key with the first
+// identity that matches a recipient and returns a reader of the
+// plaintext. Reads fail with ErrAuthentication, ErrTruncated or
+// ErrTrailingData if the payload was changed.
+func Decrypt(r io.Reader, identities ...Identity) (io.Reader, error) {
+	h, covered, err := readHeader(r)
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range h.Stanzas {
+		if s.Type == typeScrypt && len(h.Stanzas) != 1 {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidHeader, ErrScryptNotAlone)
+		}
+	}
+
+	fileKey, err := unwrap(h.Stanzas, identities)
+	if err != nil {
+		return nil, err
+	}
+	defer clear(fileKey)
+	mac, err := headerMAC(fileKey, covered)
+	if err != nil {
+		return nil, err
+	}
+	if !hmac.Equal(mac, h.MAC) {
+		return nil, fmt.Errorf("%w: MAC mismatch", ErrInvalidHeader)
+	}
+
+	key, err := payloadKey(fileKey, h.Nonce)
+	if err != nil {
+		return nil, err
+	}
+	return newReader(key, r)
+}
+
+// unwrap tries every identity against every stanza.
+func unwrap(stanzas []stanza, identities []Identity) ([]byte, error) {
+	for _, id := range identities {
+		for _, s := range stanzas {
+			key, err := id.unwrap(s)
+			if errors.Is(err, errNoMatch) {
+				continue
+			}
+			if err != nil {
+				return nil, err
+			}
+			return key, nil
+		}
+	}
+	return nil, ErrNoIdentityMatch
+}
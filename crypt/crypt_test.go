@@ -0,0 +1,293 @@
+// crypt_test.go - Test Program `crypt` Package
+//
+//     ॐ भूर्भुवः स्वः
+//     तत्स॑वि॒तुर्वरे॑ण्यं॒
+//    भर्गो॑ दे॒वस्य॑ धीमहि।
+//   धियो॒ यो नः॑ प्रचो॒दया॑त्॥
+//
+//
+//  बी.स.जी - बोसजी के द्वारा रचित सुरक्षा एवं गोपनीयता हेतु तन्त्राक्ष्।
+// ================================================
+//
+// एक सुरक्षा एवं गोपनीयता केंद्रित तंत्राक्षों का संकलन।
+//
+// एक रचनात्मक भारतीय उत्पाद ।
+//
+// bsg - Boseji's Security and Privacy Utilities
+//
+// A collection of Security and Privacy utilities and some notes for help.
+//
+// This is **Golang** package collection as well as few utility
+// command line programs.
+//
+// Sources
+// -------
+// https://github.com/boseji/bsg
+//
+// License
+// -------
+//
+//   bsg - Boseji's Security and Privacy Utilities.
+//   Copyright (C) 2025-2026 by Abhijit Bose (aka. Boseji)
+//
+//   This program is free software: you can redistribute it and/or modify
+//   it under the terms of the GNU General Public License version 2 only
+//   as published by the Free Software Foundation.
+//
+//   This program is distributed in the hope that it will be useful,
+//   but WITHOUT ANY WARRANTY; without even the implied warranty of
+//   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+//
+//   You should have received a copy of the GNU General Public License
+//   along with this program. If not, see <https://www.gnu.org/licenses/>.
+//
+//  SPDX-License-Identifier: GPL-2.0-only
+//  Full Name: GNU General Public License v2.0 only
+//  Please visit <https://spdx.org/licenses/GPL-2.0-only.html> for details.
+//
+
+package crypt_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/boseji/bsg/crypt"
+)
+
+// testData returns n bytes of a repeating pattern.
+func testData(n int) []byte {
+	b := make([]byte, n)
+	for i := range b {
+		b[i] = byte(i * 7)
+	}
+	return b
+}
+
+func encrypt(t *testing.T, data []byte, recipients ...crypt.Recipient) []byte {
+	t.Helper()
+	var out bytes.Buffer
+	w, err := crypt.Encrypt(&out, recipients...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := w.Write(data); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return out.Bytes()
+}
+
+func decrypt(enc []byte, identities ...crypt.Identity) ([]byte, error) {
+	r, err := crypt.Decrypt(bytes.NewReader(enc), identities...)
+	if err != nil {
+		return nil, err
+	}
+	return io.ReadAll(r)
+}
+
+func passphrase(t *testing.T, pass string) (*crypt.ScryptRecipient, *crypt.ScryptIdentity) {
+	t.Helper()
+	r, err := crypt.NewScryptRecipient(pass, crypt.WithWorkFactor(10))
+	if err != nil {
+		t.Fatal(err)
+	}
+	i, err := crypt.NewScryptIdentity(pass)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return r, i
+}
+
+func TestRoundTrip(t *testing.T) {
+	id, err := crypt.GenerateX25519Identity()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sr, si := passphrase(t, "correct horse")
+	sizes := []int{0, 1, crypt.ChunkSize - 1, crypt.ChunkSize,
+		crypt.ChunkSize + 1, 3 * crypt.ChunkSize}
+	for _, n := range sizes {
+		data := testData(n)
+		got, err := decrypt(encrypt(t, data, id.Recipient()), id)
+		if err != nil || !bytes.Equal(got, data) {
+			t.Fatalf("X25519 size %d: %d bytes, %v", n, len(got), err)
+		}
+		got, err = decrypt(encrypt(t, data, sr), si)
+		if err != nil || !bytes.Equal(got, data) {
+			t.Fatalf("scrypt size %d: %d bytes, %v", n, len(got), err)
+		}
+	}
+}
+
+func TestSmallWrites(t *testing.T) {
+	id, _ := crypt.GenerateX25519Identity()
+	data := testData(2*crypt.ChunkSize + 100)
+	var out bytes.Buffer
+	w, err := crypt.Encrypt(&out, id.Recipient())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < len(data); i += 1000 {
+		w.Write(data[i:min(i+1000, len(data))])
+	}
+	w.Close()
+	got, err := decrypt(out.Bytes(), id)
+	if err != nil || !bytes.Equal(got, data) {
+		t.Fatalf("got %d bytes, %v", len(got), err)
+	}
+}
+
+func TestMultipleRecipients(t *testing.T) {
+	a, _ := crypt.GenerateX25519Identity()
+	b, _ := crypt.GenerateX25519Identity()
+	c, _ := crypt.GenerateX25519Identity()
+	enc := encrypt(t, []byte("shared"), a.Recipient(), b.Recipient())
+	for _, id := range []*crypt.X25519Identity{a, b} {
+		if got, err := decrypt(enc, id); err != nil || string(got) != "shared" {
+			t.Fatalf("recipient: %q, %v", got, err)
+		}
+	}
+	if _, err := decrypt(enc, c); !errors.Is(err, crypt.ErrNoIdentityMatch) {
+		t.Fatalf("stranger: %v", err)
+	}
+	// Any matching identity in the list is enough.
+	if got, err := decrypt(enc, c, b); err != nil || string(got) != "shared" {
+		t.Fatalf("identity list: %q, %v", got, err)
+	}
+
+	sr, _ := passphrase(t, "pass")
+	if _, err := crypt.Encrypt(io.Discard, sr, a.Recipient()); !errors.Is(err, crypt.ErrScryptNotAlone) {
+		t.Fatalf("scrypt with others: %v", err)
+	}
+	if _, err := crypt.Encrypt(io.Discard); !errors.Is(err, crypt.ErrNoRecipients) {
+		t.Fatalf("no recipients: %v", err)
+	}
+}
+
+func TestWrongPassphrase(t *testing.T) {
+	sr, _ := passphrase(t, "right")
+	_, wrong := passphrase(t, "wrong")
+	enc := encrypt(t, []byte("data"), sr)
+	if _, err := decrypt(enc, wrong); !errors.Is(err, crypt.ErrWrongPassphrase) {
+		t.Fatalf("want ErrWrongPassphrase, got %v", err)
+	}
+	if _, err := crypt.NewScryptRecipient(""); !errors.Is(err, crypt.ErrInvalidPassphrase) {
+		t.Fatalf("empty passphrase: %v", err)
+	}
+	if _, err := crypt.NewScryptRecipient("x", crypt.WithWorkFactor(40)); !errors.Is(err, crypt.ErrWorkFactor) {
+		t.Fatalf("work factor: %v", err)
+	}
+}
+
+func TestKeyEncoding(t *testing.T) {
+	id, _ := crypt.GenerateX25519Identity()
+	s := id.String()
+	if !strings.HasPrefix(s, "BSG-SECRET-KEY-1") {
+		t.Fatalf("identity %q", s)
+	}
+	p := id.Recipient().String()
+	if !strings.HasPrefix(p, "bsgpub1") {
+		t.Fatalf("recipient %q", p)
+	}
+	id2, err := crypt.ParseX25519Identity(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r2, err := crypt.ParseX25519Recipient(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, err := decrypt(encrypt(t, []byte("k"), r2), id2); err != nil || string(got) != "k" {
+		t.Fatalf("parsed keys: %q, %v", got, err)
+	}
+	for _, bad := range []string{p, "bsgpub1qqqqqq", ""} {
+		if _, err := crypt.ParseX25519Identity(bad); !errors.Is(err, crypt.ErrInvalidKey) {
+			t.Errorf("ParseX25519Identity(%q): %v", bad, err)
+		}
+	}
+	if _, err := crypt.ParseX25519Recipient(s); !errors.Is(err, crypt.ErrInvalidKey) {
+		t.Errorf("secret key parsed as recipient: %v", err)
+	}
+}
+
+func TestTamper(t *testing.T) {
+	id, _ := crypt.GenerateX25519Identity()
+	data := testData(3*crypt.ChunkSize + 10)
+	enc := encrypt(t, data, id.Recipient())
+	const chunk = crypt.ChunkSize + 16
+	hdr := len(enc) - 3*chunk - (10 + 16)
+
+	flip := func(i int) []byte {
+		b := bytes.Clone(enc)
+		b[i] ^= 1
+		return b
+	}
+	swap := func() []byte {
+		b := bytes.Clone(enc)
+		c0 := bytes.Clone(b[hdr : hdr+chunk])
+		copy(b[hdr:], b[hdr+chunk:hdr+2*chunk])
+		copy(b[hdr+chunk:], c0)
+		return b
+	}
+	drop := func() []byte {
+		b := bytes.Clone(enc[:hdr+chunk])
+		return append(b, enc[hdr+2*chunk:]...)
+	}
+
+	tests := []struct {
+		name string
+		enc  []byte
+		want error
+	}{
+		{"header version", flip(6), crypt.ErrUnsupported},
+		{"header magic", flip(0), crypt.ErrInvalidHeader},
+		{"header nonce", flip(hdr - 40), crypt.ErrInvalidHeader},
+		{"header mac", flip(hdr - 1), crypt.ErrInvalidHeader},
+		{"chunk body", flip(hdr + 100), crypt.ErrAuthentication},
+		{"last chunk", flip(len(enc) - 1), crypt.ErrAuthentication},
+		{"reordered", swap(), crypt.ErrAuthentication},
+		{"chunk dropped", drop(), crypt.ErrAuthentication},
+		{"truncated at chunk", enc[:hdr+2*chunk], crypt.ErrTruncated},
+		{"truncated mid chunk", enc[:hdr+chunk+100], crypt.ErrAuthentication},
+		{"only header", enc[:hdr], crypt.ErrTruncated},
+		{"header cut", enc[:hdr-5], crypt.ErrInvalidHeader},
+		{"trailing data", append(bytes.Clone(enc), 0), crypt.ErrAuthentication},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := decrypt(tt.enc, id)
+			if !errors.Is(err, tt.want) {
+				t.Fatalf("want %v, got %v after %d bytes", tt.want, err, len(got))
+			}
+		})
+	}
+
+	// A final chunk of exactly ChunkSize followed by more data.
+	full := encrypt(t, testData(crypt.ChunkSize), id.Recipient())
+	if _, err := decrypt(append(full, 1, 2, 3), id); !errors.Is(err, crypt.ErrTrailingData) {
+		t.Fatalf("trailing after full final chunk: %v", err)
+	}
+}
+
+func TestReaderReleasesOnlyAuthenticatedData(t *testing.T) {
+	id, _ := crypt.GenerateX25519Identity()
+	enc := encrypt(t, testData(2*crypt.ChunkSize), id.Recipient())
+	enc[len(enc)-1] ^= 1
+	r, err := crypt.Decrypt(bytes.NewReader(enc), id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := io.ReadAll(r)
+	if !errors.Is(err, crypt.ErrAuthentication) {
+		t.Fatalf("want ErrAuthentication, got %v", err)
+	}
+	if len(got) != crypt.ChunkSize {
+		t.Fatalf("released %d bytes, want only the first chunk", len(got))
+	}
+}
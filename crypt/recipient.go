@@ -0,0 +1,350 @@
+// recipient.go - Part of the `crypt` Package
+//
+//     ॐ भूर्भुवः स्वः
+//     तत्स॑वि॒तुर्वरे॑ण्यं॒
+//    भर्गो॑ दे॒वस्य॑ धीमहि।
+//   धियो॒ यो नः॑ प्रचो॒दया॑त्॥
+//
+//
+//  बी.स.जी - बोसजी के द्वारा रचित सुरक्षा एवं गोपनीयता हेतु तन्त्राक्ष्।
+// ================================================
+//
+// एक सुरक्षा एवं गोपनीयता केंद्रित तंत्राक्षों का संकलन।
+//
+// एक रचनात्मक भारतीय उत्पाद ।
+//
+// bsg - Boseji's Security and Privacy Utilities
+//
+// A collection of Security and Privacy utilities and some notes for help.
+//
+// This is **Golang** package collection as well as few utility
+// command line programs.
+//
+// Sources
+// -------
+// https://github.com/boseji/bsg
+//
+// License
+// -------
+//
+//   bsg - Boseji's Security and Privacy Utilities.
+//   Copyright (C) 2025-2026 by Abhijit Bose (aka. Boseji)
+//
+//   This program is free software: you can redistribute it and/or modify
+//   it under the terms of the GNU General Public License version 2 only
+//   as published by the Free Software Foundation.
+//
+//   This program is distributed in the hope that it will be useful,
+//   but WITHOUT ANY WARRANTY; without even the implied warranty of
+//   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+//
+//   You should have received a copy of the GNU General Public License
+//   along with this program. If not, see <https://www.gnu.org/licenses/>.
+//
+//  SPDX-License-Identifier: GPL-2.0-only
+//  Full Name: GNU General Public License v2.0 only
+//  Please visit <https://spdx.org/licenses/GPL-2.0-only.html> for details.
+//
+
+package crypt
+
+import (
+	"crypto/ecdh"
+	"crypto/hkdf"
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/boseji/bsg/gen"
+	"golang.org/x/crypto/chacha20poly1305"
+	"golang.org/x/crypto/scrypt"
+)
+
+// Stanza types in the header.
+const (
+	typeScrypt byte = 1
+	typeX25519 byte = 2
+)
+
+const (
+	// DefaultWorkFactor is the scrypt cost exponent, N = 2^18.
+	DefaultWorkFactor = 18
+
+	// MaxWorkFactor is the largest scrypt cost exponent accepted when
+	// decrypting, so a hostile header cannot demand unbounded work.
+	MaxWorkFactor = 22
+
+	scryptSaltSize = 16
+
+	// Bech32 prefixes of X25519 keys.
+	publicKeyPrefix = "bsgpub"
+	secretKeyPrefix = "bsg-secret-key-"
+)
+
+// errNoMatch reports that an identity cannot open a stanza.
+var errNoMatch = errors.New("crypt: stanza not for this identity")
+
+// Recipient wraps the file key so that a matching Identity can unwrap
+// it. It is implemented by ScryptRecipient and X25519Recipient.
+type Recipient interface {
+	wrap(fileKey []byte) (stanza, error)
+}
+
+// Identity unwraps a file key wrapped by its Recipient. It is
+// implemented by ScryptIdentity and X25519Identity.
+type Identity interface {
+	unwrap(s stanza) ([]byte, error)
+}
+
+// ScryptOptions holds the parameters of a passphrase recipient.
+type ScryptOptions struct {
+	WorkFactor int // scrypt cost exponent (default is DefaultWorkFactor).
+}
+
+// ScryptOption is a functional option for NewScryptRecipient.
+type ScryptOption func(*ScryptOptions)
+
+// WithWorkFactor sets the scrypt cost exponent, N = 2^logN.
+func WithWorkFactor(logN int) ScryptOption {
+	return func(o *ScryptOptions) {
+		o.WorkFactor = logN
+	}
+}
+
+// ScryptRecipient encrypts to a passphrase. It must be the only
+// recipient of a stream.
+type ScryptRecipient struct {
+	passphrase []byte
+	workFactor int
+}
+
+// NewScryptRecipient returns a recipient for the passphrase.
+func NewScryptRecipient(passphrase string, opts ...ScryptOption) (*ScryptRecipient, error) {
+	options := &ScryptOptions{WorkFactor: DefaultWorkFactor}
+	for _, opt := range opts {
+		opt(options)
+	}
+	if passphrase == "" {
+		return nil, ErrInvalidPassphrase
+	}
+	if options.WorkFactor < 1 || options.WorkFactor > MaxWorkFactor {
+		return nil, fmt.Errorf("%w: %d", ErrWorkFactor, options.WorkFactor)
+	}
+	return &ScryptRecipient{passphrase: []byte(passphrase),
+		workFactor: options.WorkFactor}, nil
+}
+
+// wrap implements Recipient. The body is salt, work factor and the
+// wrapped file key.
+func (r *ScryptRecipient) wrap(fileKey []byte) (stanza, error) {
+	salt := make([]byte, scryptSaltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return stanza{}, err
+	}
+	key, err := scryptKey(r.passphrase, salt, r.workFactor)
+	if err != nil {
+		return stanza{}, err
+	}
+	wrapped, err := aeadWrap(key, fileKey)
+	if err != nil {
+		return stanza{}, err
+	}
+	body := append(append(salt, byte(r.workFactor)), wrapped...)
+	return stanza{Type: typeScrypt, Body: body}, nil
+}
+
+// ScryptIdentity decrypts streams encrypted to a passphrase.
+type ScryptIdentity struct {
+	passphrase []byte
+}
+
+// NewScryptIdentity returns an identity for the passphrase.
+func NewScryptIdentity(passphrase string) (*ScryptIdentity, error) {
+	if passphrase == "" {
+		return nil, ErrInvalidPassphrase
+	}
+	return &ScryptIdentity{passphrase: []byte(passphrase)}, nil
+}
+
+// unwrap implements Identity.
+func (i *ScryptIdentity) unwrap(s stanza) ([]byte, error) {
+	if s.Type != typeScrypt {
+		return nil, errNoMatch
+	}
+	if len(s.Body) != scryptSaltSize+1+fileKeySize+chacha20poly1305.Overhead {
+		return nil, fmt.Errorf("%w: bad scrypt stanza", ErrInvalidHeader)
+	}
+	salt, logN := s.Body[:scryptSaltSize], int(s.Body[scryptSaltSize])
+	if logN < 1 || logN > MaxWorkFactor {
+		return nil, fmt.Errorf("%w: %d", ErrWorkFactor, logN)
+	}
+	key, err := scryptKey(i.passphrase, salt, logN)
+	if err != nil {
+		return nil, err
+	}
+	fileKey, err := aeadUnwrap(key, s.Body[scryptSaltSize+1:])
+	if err != nil {
+		return nil, ErrWrongPassphrase
+	}
+	return fileKey, nil
+}
+
+// scryptKey derives the wrapping key for a passphrase.
+func scryptKey(passphrase, salt []byte, logN int) ([]byte, error) {
+	s := append([]byte("bsg/crypt scrypt"), salt...)
+	return scrypt.Key(passphrase, s, 1<<logN, 8, 1, chacha20poly1305.KeySize)
+}
+
+// X25519Recipient encrypts to an X25519 public key.
+type X25519Recipient struct {
+	key *ecdh.PublicKey
+}
+
+// X25519Identity holds an X25519 private key.
+type X25519Identity struct {
+	key *ecdh.PrivateKey
+}
+
+// GenerateX25519Identity creates a new random X25519 identity.
+func GenerateX25519Identity() (*X25519Identity, error) {
+	k, err := ecdh.X25519().GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	return &X25519Identity{key: k}, nil
+}
+
+// Recipient returns the public half of the identity.
+func (i *X25519Identity) Recipient() *X25519Recipient {
+	return &X25519Recipient{key: i.key.PublicKey()}
+}
+
+// String returns the identity as upper case Bech32 with the prefix
+// BSG-SECRET-KEY-.
+func (i *X25519Identity) String() string {
+	s, _ := gen.EncodeBech32(secretKeyPrefix, i.key.Bytes(), gen.Bech32)
+	return strings.ToUpper(s)
+}
+
+// String returns the recipient as Bech32 with the prefix bsgpub.
+func (r *X25519Recipient) String() string {
+	s, _ := gen.EncodeBech32(publicKeyPrefix, r.key.Bytes(), gen.Bech32)
+	return s
+}
+
+// ParseX25519Identity parses the text form of an identity.
+func ParseX25519Identity(s string) (*X25519Identity, error) {
+	b, err := parseKey(s, secretKeyPrefix)
+	if err != nil {
+		return nil, err
+	}
+	k, err := ecdh.X25519().NewPrivateKey(b)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidKey, err)
+	}
+	return &X25519Identity{key: k}, nil
+}
+
+// ParseX25519Recipient parses the text form of a recipient.
+func ParseX25519Recipient(s string) (*X25519Recipient, error) {
+	b, err := parseKey(s, publicKeyPrefix)
+	if err != nil {
+		return nil, err
+	}
+	k, err := ecdh.X25519().NewPublicKey(b)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidKey, err)
+	}
+	return &X25519Recipient{key: k}, nil
+}
+
+// parseKey decodes a Bech32 key and checks its prefix.
+func parseKey(s, prefix string) ([]byte, error) {
+	hrp, b, v, err := gen.DecodeBech32(strings.TrimSpace(s))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidKey, err)
+	}
+	if hrp != prefix || v != gen.Bech32 || len(b) != 32 {
+		return nil, fmt.Errorf("%w: expected a %s key", ErrInvalidKey, prefix)
+	}
+	return b, nil
+}
+
+// wrap implements Recipient. The body is an ephemeral public key and
+// the wrapped file key.
+func (r *X25519Recipient) wrap(fileKey []byte) (stanza, error) {
+	eph, err := ecdh.X25519().GenerateKey(rand.Reader)
+	if err != nil {
+		return stanza{}, err
+	}
+	shared, err := eph.ECDH(r.key)
+	if err != nil {
+		return stanza{}, err
+	}
+	key, err := x25519Key(shared, eph.PublicKey().Bytes(), r.key.Bytes())
+	if err != nil {
+		return stanza{}, err
+	}
+	wrapped, err := aeadWrap(key, fileKey)
+	if err != nil {
+		return stanza{}, err
+	}
+	return stanza{Type: typeX25519,
+		Body: append(eph.PublicKey().Bytes(), wrapped...)}, nil
+}
+
+// unwrap implements Identity.
+func (i *X25519Identity) unwrap(s stanza) ([]byte, error) {
+	if s.Type != typeX25519 {
+		return nil, errNoMatch
+	}
+	if len(s.Body) != 32+fileKeySize+chacha20poly1305.Overhead {
+		return nil, fmt.Errorf("%w: bad X25519 stanza", ErrInvalidHeader)
+	}
+	eph, err := ecdh.X25519().NewPublicKey(s.Body[:32])
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidHeader, err)
+	}
+	shared, err := i.key.ECDH(eph)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidHeader, err)
+	}
+	key, err := x25519Key(shared, s.Body[:32], i.key.PublicKey().Bytes())
+	if err != nil {
+		return nil, err
+	}
+	fileKey, err := aeadUnwrap(key, s.Body[32:])
+	if err != nil {
+		// Wrapped for a different key.
+		return nil, errNoMatch
+	}
+	return fileKey, nil
+}
+
+// x25519Key derives the wrapping key from the shared secret, binding
+// both public keys.
+func x25519Key(shared, ephPub, recipientPub []byte) ([]byte, error) {
+	salt := append(append([]byte{}, ephPub...), recipientPub...)
+	return hkdf.Key(sha256.New, shared, salt, "bsg/crypt x25519", chacha20poly1305.KeySize)
+}
+
+// aeadWrap seals the file key. Each wrapping key is used once, so the
+// nonce is zero.
+func aeadWrap(key, fileKey []byte) ([]byte, error) {
+	a, err := chacha20poly1305.New(key)
+	if err != nil {
+		return nil, err
+	}
+	return a.Seal(nil, make([]byte, a.NonceSize()), fileKey, nil), nil
+}
+
+// aeadUnwrap opens a file key sealed by aeadWrap.
+func aeadUnwrap(key, wrapped []byte) ([]byte, error) {
+	a, err := chacha20poly1305.New(key)
+	if err != nil {
+		return nil, err
+	}
+	return a.Open(nil, make([]byte, a.NonceSize()), wrapped, nil)
+}
@@ -0,0 +1,235 @@
+// stream.go - Part of the `crypt` Package
+//
+//     ॐ भूर्भुवः स्वः
+//     तत्स॑वि॒तुर्वरे॑ण्यं॒
+//    भर्गो॑ दे॒वस्य॑ धीमहि।
+//   धियो॒ यो नः॑ प्रचो॒दया॑त्॥
+//
+//
+//  बी.स.जी - बोसजी के द्वारा रचित सुरक्षा एवं गोपनीयता हेतु तन्त्राक्ष्।
+// ================================================
+//
+// एक सुरक्षा एवं गोपनीयता केंद्रित तंत्राक्षों का संकलन।
+//
+// एक रचनात्मक भारतीय उत्पाद ।
+//
+// bsg - Boseji's Security and Privacy Utilities
+//
+// A collection of Security and Privacy utilities and some notes for help.
+//
+// This is **Golang** package collection as well as few utility
+// command line programs.
+//
+// Sources
+// -------
+// https://github.com/boseji/bsg
+//
+// License
+// -------
+//
+//   bsg - Boseji's Security and Privacy Utilities.
+//   Copyright (C) 2025-2026 by Abhijit Bose (aka. Boseji)
+//
+//   This program is free software: you can redistribute it and/or modify
+//   it under the terms of the GNU General Public License version 2 only
+//   as published by the Free Software Foundation.
+//
+//   This program is distributed in the hope that it will be useful,
+//   but WITHOUT ANY WARRANTY; without even the implied warranty of
+//   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+//
+//   You should have received a copy of the GNU General Public License
+//   along with this program. If not, see <https://www.gnu.org/licenses/>.
+//
+//  SPDX-License-Identifier: GPL-2.0-only
+//  Full Name: GNU General Public License v2.0 only
+//  Please visit <https://spdx.org/licenses/GPL-2.0-only.html> for details.
+//
+
+package crypt
+
+import (
+	"crypto/cipher"
+	"errors"
+	"io"
+
+	"golang.org/x/crypto/chacha20poly1305"
+)
+
+// encChunkSize is the size of a full chunk with its tag.
+const encChunkSize = ChunkSize + chacha20poly1305.Overhead
+
+// lastChunkFlag marks the nonce of the final chunk.
+const lastChunkFlag = 0x01
+
+var errCounterOverflow = errors.New("crypt: chunk counter overflow")
+
+// streamNonce is the STREAM nonce: an 11 byte big-endian chunk counter
+// followed by the final-chunk flag.
+type streamNonce [chacha20poly1305.NonceSize]byte
+
+// next advances the counter.
+func (n *streamNonce) next() error {
+	for i := len(n) - 2; i >= 0; i-- {
+		n[i]++
+		if n[i] != 0 {
+			return nil
+		}
+	}
+	return errCounterOverflow
+}
+
+// with returns the nonce with the final-chunk flag set or cleared.
+func (n streamNonce) with(last bool) []byte {
+	if last {
+		n[len(n)-1] = lastChunkFlag
+	} else {
+		n[len(n)-1] = 0
+	}
+	return n[:]
+}
+
+// writer encrypts chunks as they fill up.
+type writer struct {
+	aead   cipher.AEAD
+	w      io.Writer
+	buf    []byte
+	out    []byte
+	nonce  streamNonce
+	err    error
+	closed bool
+}
+
+// newWriter returns a STREAM writer under key.
+func newWriter(key []byte, w io.Writer) (*writer, error) {
+	a, err := chacha20poly1305.New(key)
+	if err != nil {
+		return nil, err
+	}
+	return &writer{aead: a, w: w, buf: make([]byte, 0, ChunkSize),
+		out: make([]byte, 0, encChunkSize)}, nil
+}
+
+// Write buffers p, encrypting every full chunk once more data follows
+// it, so that the final chunk is only known at Close.
+func (w *writer) Write(p []byte) (int, error) {
+	if w.closed {
+		return 0, errors.New("crypt: write after close")
+	}
+	n := 0
+	for len(p) > 0 {
+		if w.err != nil {
+			return n, w.err
+		}
+		if len(w.buf) == ChunkSize {
+			w.flush(false)
+			continue
+		}
+		c := copy(w.buf[len(w.buf):ChunkSize], p)
+		w.buf = w.buf[:len(w.buf)+c]
+		p = p[c:]
+		n += c
+	}
+	return n, w.err
+}
+
+// Close encrypts the final chunk. It does not close the underlying
+// writer.
+func (w *writer) Close() error {
+	if w.closed {
+		return w.err
+	}
+	w.closed = true
+	if w.err == nil {
+		w.flush(true)
+	}
+	clear(w.buf[:cap(w.buf)])
+	return w.err
+}
+
+// flush encrypts and writes the buffered chunk.
+func (w *writer) flush(last bool) {
+	w.out = w.aead.Seal(w.out[:0], w.nonce.with(last), w.buf, nil)
+	if _, err := w.w.Write(w.out); err != nil {
+		w.err = err
+		return
+	}
+	w.buf = w.buf[:0]
+	if !last {
+		w.err = w.nonce.next()
+	}
+}
+
+// reader decrypts chunks on demand.
+type reader struct {
+	aead  cipher.AEAD
+	r     io.Reader
+	buf   []byte // encChunkSize+1 so the end of stream is seen early.
+	carry int    // bytes at the front of buf from the previous read.
+	plain []byte
+	out   []byte
+	nonce streamNonce
+	err   error
+}
+
+// newReader returns a STREAM reader under key.
+func newReader(key []byte, r io.Reader) (*reader, error) {
+	a, err := chacha20poly1305.New(key)
+	if err != nil {
+		return nil, err
+	}
+	return &reader{aead: a, r: r, buf: make([]byte, encChunkSize+1),
+		out: make([]byte, 0, ChunkSize)}, nil
+}
+
+// Read returns decrypted plaintext. Only authenticated data is returned.
+func (r *reader) Read(p []byte) (int, error) {
+	for len(r.plain) == 0 {
+		if r.err != nil {
+			return 0, r.err
+		}
+		r.err = r.readChunk()
+	}
+	n := copy(p, r.plain)
+	r.plain = r.plain[n:]
+	return n, nil
+}
+
+// readChunk reads and opens the next chunk. Reading one byte past a
+// full chunk tells whether it should be the final one.
+func (r *reader) readChunk() error {
+	n, err := io.ReadFull(r.r, r.buf[r.carry:])
+	total := r.carry + n
+	r.carry = 0
+	switch err {
+	case nil:
+		chunk := r.buf[:encChunkSize]
+		plain, err := r.aead.Open(r.out[:0], r.nonce.with(false), chunk, nil)
+		if err != nil {
+			if _, err := r.aead.Open(r.out[:0], r.nonce.with(true), chunk, nil); err == nil {
+				return ErrTrailingData
+			}
+			return ErrAuthentication
+		}
+		r.plain = plain
+		r.buf[0] = r.buf[encChunkSize]
+		r.carry = 1
+		return r.nonce.next()
+	case io.EOF, io.ErrUnexpectedEOF:
+		if total < chacha20poly1305.Overhead {
+			return ErrTruncated
+		}
+		chunk := r.buf[:total]
+		plain, err := r.aead.Open(r.out[:0], r.nonce.with(true), chunk, nil)
+		if err != nil {
+			if _, err := r.aead.Open(r.out[:0], r.nonce.with(false), chunk, nil); err == nil {
+				return ErrTruncated
+			}
+			return ErrAuthentication
+		}
+		r.plain = plain
+		return io.EOF
+	default:
+		return err
+	}
+}
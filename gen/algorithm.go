@@ -0,0 +1,400 @@
+// algorithm.go - Part of the `gen` Package
+//
+//     ॐ भूर्भुवः स्वः
+//     तत्स॑वि॒तुर्वरे॑ण्यं॒
+//    भर्गो॑ दे॒वस्य॑ धीमहि।
+//   धियो॒ यो नः॑ प्रचो॒दया॑त्॥
+//
+//
+//  बी.स.जी - बोसजी के द्वारा रचित सुरक्षा एवं गोपनीयता हेतु तन्त्राक्ष्।
+// ================================================
+//
+// एक सुरक्षा एवं गोपनीयता केंद्रित तंत्राक्षों का संकलन।
+//
+// एक रचनात्मक भारतीय उत्पाद ।
+//
+// bsg - Boseji's Security and Privacy Utilities
+//
+// A collection of Security and Privacy utilities and some notes for help.
+//
+// This is **Golang** package collection as well as few utility
+// command line programs.
+//
+// Sources
+// -------
+// https://github.com/boseji/bsg
+//
+// License
+// -------
+//
+//   bsg - Boseji's Security and Privacy Utilities.
+//   Copyright (C) 2025-2026 by Abhijit Bose (aka. Boseji)
+//
+//   This program is free software: you can redistribute it and/or modify
+//   it under the terms of the GNU General Public License version 2 only
+//   as published by the Free Software Foundation.
+//
+//   This program is distributed in the hope that it will be useful,
+//   but WITHOUT ANY WARRANTY; without even the implied warranty of
+//   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+//
+//   You should have received a copy of the GNU General Public License
+//   along with this program. If not, see <https://www.gnu.org/licenses/>.
+//
+//  SPDX-License-Identifier: GPL-2.0-only
+//  Full Name: GNU General Public License v2.0 only
+//  Please visit <https://spdx.org/licenses/GPL-2.0-only.html> for details.
+//
+
+package gen
+
+import (
+	"context"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha3"
+	"crypto/sha512"
+	"crypto/subtle"
+	"encoding/binary"
+	hx "encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+var (
+	ErrUnknownAlgorithm = errors.New("unknown hash algorithm")
+	ErrInvalidDigest    = errors.New("invalid digest")
+)
+
+// Algorithm describes a hash function known to the registry.
+type Algorithm struct {
+	Name string           // Canonical name, e.g. "sha256" or "shake256/64".
+	Size int              // Digest size in bytes.
+	Code uint64           // Multihash code, 0 if there is none.
+	New  func() hash.Hash // Hash constructor, usable with totp.WithAlgorithm.
+}
+
+// String returns the canonical name of the algorithm.
+func (a Algorithm) String() string {
+	return a.Name
+}
+
+// Sum computes the digest of the data.
+func (a Algorithm) Sum(data []byte) []byte {
+	h := a.New()
+	h.Write(data)
+	return h.Sum(nil)
+}
+
+// Digest computes the self-describing digest of the data.
+func (a Algorithm) Digest(data []byte) Digest {
+	return Digest{Algorithm: a, Sum: a.Sum(data)}
+}
+
+// algEntry is one registry slot. Extendable output functions carry a
+// SHAKE constructor instead of a fixed one.
+type algEntry struct {
+	alg  Algorithm
+	xof  func() *sha3.SHAKE
+	base string
+}
+
+var (
+	algMu      sync.RWMutex
+	algEntries = map[string]algEntry{}
+	algAliases = map[string]string{}
+)
+
+func init() {
+	fixed := []struct {
+		alg     Algorithm
+		aliases []string
+	}{
+		{Algorithm{"sha1", sha1.Size, 0x11, sha1.New}, []string{"sha-1"}},
+		{Algorithm{"sha256", sha256.Size, 0x12, sha256.New},
+			[]string{"sha-256", "sha2-256"}},
+		{Algorithm{"sha384", sha512.Size384, 0x20, sha512.New384},
+			[]string{"sha-384", "sha2-384"}},
+		{Algorithm{"sha512", sha512.Size, 0x13, sha512.New},
+			[]string{"sha-512", "sha2-512"}},
+		{Algorithm{"sha3-256", 32, 0x16, newSHA3_256}, []string{"sha3_256"}},
+		{Algorithm{"sha3-512", 64, 0x14, newSHA3_512}, []string{"sha3_512"}},
+	}
+	for _, f := range fixed {
+		if err := RegisterAlgorithm(f.alg, f.aliases...); err != nil {
+			panic(err)
+		}
+	}
+
+	// SHAKE default sizes give the full security strength of each.
+	algEntries["shake128"] = algEntry{
+		alg: Algorithm{Name: "shake128", Size: 32, Code: 0x18},
+		xof: sha3.NewSHAKE128, base: "shake128",
+	}
+	algEntries["shake256"] = algEntry{
+		alg: Algorithm{Name: "shake256", Size: 64, Code: 0x19},
+		xof: sha3.NewSHAKE256, base: "shake256",
+	}
+	algAliases["shake-128"] = "shake128"
+	algAliases["shake-256"] = "shake256"
+}
+
+// RegisterAlgorithm adds a fixed size hash to the registry under its
+// canonical name and any aliases. Names are case-insensitive.
+func RegisterAlgorithm(a Algorithm, aliases ...string) error {
+	name := normaliseAlgorithm(a.Name)
+	if name == "" || strings.ContainsAny(name, ":/") {
+		return fmt.Errorf("invalid algorithm name %q", a.Name)
+	}
+	if a.New == nil || a.Size <= 0 {
+		return fmt.Errorf("algorithm %q needs a constructor and size", a.Name)
+	}
+
+	algMu.Lock()
+	defer algMu.Unlock()
+	names := append([]string{name}, aliases...)
+	for _, n := range names {
+		n = normaliseAlgorithm(n)
+		if _, ok := algEntries[n]; ok {
+			return fmt.Errorf("algorithm %q already registered", n)
+		}
+		if _, ok := algAliases[n]; ok {
+			return fmt.Errorf("algorithm %q already registered", n)
+		}
+	}
+	for _, e := range algEntries {
+		if a.Code != 0 && e.alg.Code == a.Code {
+			return fmt.Errorf("multihash code 0x%x already used by %q",
+				a.Code, e.alg.Name)
+		}
+	}
+	a.Name = name
+	algEntries[name] = algEntry{alg: a}
+	for _, n := range aliases {
+		algAliases[normaliseAlgorithm(n)] = name
+	}
+	return nil
+}
+
+// ParseAlgorithm looks up an algorithm by its canonical name or alias.
+// SHAKE functions take an optional output size in bytes after a slash,
+// e.g. "shake256/64".
+func ParseAlgorithm(name string) (Algorithm, error) {
+	n := normaliseAlgorithm(name)
+	size := 0
+	if i := strings.IndexByte(n, '/'); i >= 0 {
+		v, err := strconv.Atoi(n[i+1:])
+		if err != nil || v <= 0 {
+			return Algorithm{}, fmt.Errorf("%w: %q", ErrUnknownAlgorithm, name)
+		}
+		n, size = n[:i], v
+	}
+
+	algMu.RLock()
+	if c, ok := algAliases[n]; ok {
+		n = c
+	}
+	e, ok := algEntries[n]
+	algMu.RUnlock()
+	if !ok {
+		return Algorithm{}, fmt.Errorf("%w: %q", ErrUnknownAlgorithm, name)
+	}
+
+	if e.xof == nil {
+		if size != 0 && size != e.alg.Size {
+			return Algorithm{}, fmt.Errorf("%w: %q has a fixed size of %d",
+				ErrUnknownAlgorithm, name, e.alg.Size)
+		}
+		return e.alg, nil
+	}
+	if size == 0 {
+		size = e.alg.Size
+	}
+	return shakeAlgorithm(e, size), nil
+}
+
+// Algorithms lists the canonical names of all registered algorithms.
+func Algorithms() []string {
+	algMu.RLock()
+	defer algMu.RUnlock()
+	names := make([]string, 0, len(algEntries))
+	for n := range algEntries {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Digest is a self-describing digest such as "sha256:ba7816bf...".
+type Digest struct {
+	Algorithm Algorithm
+	Sum       []byte
+}
+
+// String formats the digest as "<algorithm>:<hex>".
+func (d Digest) String() string {
+	return d.Algorithm.Name + ":" + hx.EncodeToString(d.Sum)
+}
+
+// Verify checks in constant time that data hashes to the digest.
+func (d Digest) Verify(data []byte) bool {
+	return subtle.ConstantTimeCompare(d.Algorithm.Sum(data), d.Sum) == 1
+}
+
+// VerifyReader checks that the stream hashes to the digest.
+func (d Digest) VerifyReader(ctx context.Context, r io.Reader,
+	opts ...StreamOption) (bool, error) {
+	sum, err := SumReader(ctx, r, d.Algorithm.New, opts...)
+	if err != nil {
+		return false, err
+	}
+	return subtle.ConstantTimeCompare(sum, d.Sum) == 1, nil
+}
+
+// Multihash encodes the digest in the multihash binary form:
+// varint code, varint length and the digest bytes.
+func (d Digest) Multihash() ([]byte, error) {
+	if d.Algorithm.Code == 0 {
+		return nil, fmt.Errorf("%w: %s has no multihash code",
+			ErrUnknownAlgorithm, d.Algorithm.Name)
+	}
+	b := binary.AppendUvarint(nil, d.Algorithm.Code)
+	b = binary.AppendUvarint(b, uint64(len(d.Sum)))
+	return append(b, d.Sum...), nil
+}
+
+// ParseDigest parses a digest string of the form "<algorithm>:<hex>".
+// For SHAKE functions without an explicit size the length of the hex
+// decides the output size.
+func ParseDigest(s string) (Digest, error) {
+	name, hexSum, ok := strings.Cut(strings.TrimSpace(s), ":")
+	if !ok {
+		return Digest{}, fmt.Errorf("%w: missing algorithm prefix", ErrInvalidDigest)
+	}
+	sum, err := hx.DecodeString(hexSum)
+	if err != nil {
+		return Digest{}, fmt.Errorf("%w: %v", ErrInvalidDigest, err)
+	}
+	if !strings.Contains(name, "/") && isSHAKE(name) && len(sum) > 0 {
+		name += "/" + strconv.Itoa(len(sum))
+	}
+	alg, err := ParseAlgorithm(name)
+	if err != nil {
+		return Digest{}, err
+	}
+	if len(sum) != alg.Size {
+		return Digest{}, fmt.Errorf("%w: %s needs %d bytes, got %d",
+			ErrInvalidDigest, alg.Name, alg.Size, len(sum))
+	}
+	return Digest{Algorithm: alg, Sum: sum}, nil
+}
+
+// ParseMultihash decodes a digest from the multihash binary form.
+func ParseMultihash(b []byte) (Digest, error) {
+	code, n := binary.Uvarint(b)
+	if n <= 0 {
+		return Digest{}, fmt.Errorf("%w: bad multihash code", ErrInvalidDigest)
+	}
+	b = b[n:]
+	size, n := binary.Uvarint(b)
+	if n <= 0 || uint64(len(b)-n) != size {
+		return Digest{}, fmt.Errorf("%w: bad multihash length", ErrInvalidDigest)
+	}
+	sum := b[n:]
+
+	algMu.RLock()
+	var e algEntry
+	found := false
+	for _, v := range algEntries {
+		if v.alg.Code == code {
+			e, found = v, true
+			break
+		}
+	}
+	algMu.RUnlock()
+	if !found {
+		return Digest{}, fmt.Errorf("%w: multihash code 0x%x", ErrUnknownAlgorithm, code)
+	}
+
+	alg := e.alg
+	if e.xof != nil {
+		alg = shakeAlgorithm(e, len(sum))
+	}
+	if len(sum) != alg.Size {
+		return Digest{}, fmt.Errorf("%w: %s needs %d bytes, got %d",
+			ErrInvalidDigest, alg.Name, alg.Size, len(sum))
+	}
+	return Digest{Algorithm: alg, Sum: append([]byte(nil), sum...)}, nil
+}
+
+// VerifyDigest checks the data against a digest string such as
+// "sha256:ba7816bf...".
+func VerifyDigest(s string, data []byte) (bool, error) {
+	d, err := ParseDigest(s)
+	if err != nil {
+		return false, err
+	}
+	return d.Verify(data), nil
+}
+
+// normaliseAlgorithm gives the lookup form of an algorithm name.
+func normaliseAlgorithm(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
+
+// isSHAKE reports if the name refers to one of the SHAKE functions.
+func isSHAKE(name string) bool {
+	n := normaliseAlgorithm(name)
+	algMu.RLock()
+	defer algMu.RUnlock()
+	if c, ok := algAliases[n]; ok {
+		n = c
+	}
+	return algEntries[n].xof != nil
+}
+
+// shakeAlgorithm builds the fixed size variant of a SHAKE function.
+func shakeAlgorithm(e algEntry, size int) Algorithm {
+	xof := e.xof
+	return Algorithm{
+		Name: e.base + "/" + strconv.Itoa(size),
+		Size: size,
+		Code: e.alg.Code,
+		New: func() hash.Hash {
+			return &shakeHash{s: xof(), xof: xof, size: size}
+		},
+	}
+}
+
+// shakeHash adapts a SHAKE function to hash.Hash with a fixed size.
+type shakeHash struct {
+	s    *sha3.SHAKE
+	xof  func() *sha3.SHAKE
+	size int
+}
+
+func (h *shakeHash) Write(p []byte) (int, error) { return h.s.Write(p) }
+func (h *shakeHash) Reset()                      { h.s.Reset() }
+func (h *shakeHash) Size() int                   { return h.size }
+func (h *shakeHash) BlockSize() int              { return h.s.BlockSize() }
+
+// Sum squeezes a copy of the state so that writes can continue.
+func (h *shakeHash) Sum(b []byte) []byte {
+	state, err := h.s.MarshalBinary()
+	if err != nil {
+		panic("sha3: " + err.Error())
+	}
+	c := h.xof()
+	if err := c.UnmarshalBinary(state); err != nil {
+		panic("sha3: " + err.Error())
+	}
+	out := make([]byte, h.size)
+	c.Read(out)
+	return append(b, out...)
+}
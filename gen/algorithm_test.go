@@ -0,0 +1,267 @@
+// algorithm_test.go - Test Program `gen` Package
+//
+//     ॐ भूर्भुवः स्वः
+//     तत्स॑वि॒तुर्वरे॑ण्यं॒
+//    भर्गो॑ दे॒वस्य॑ धीमहि।
+//   धियो॒ यो नः॑ प्रचो॒दया॑त्॥
+//
+//
+//  बी.स.जी - बोसजी के द्वारा रचित सुरक्षा एवं गोपनीयता हेतु तन्त्राक्ष्।
+// ================================================
+//
+// एक सुरक्षा एवं गोपनीयता केंद्रित तंत्राक्षों का संकलन।
+//
+// एक रचनात्मक भारतीय उत्पाद ।
+//
+// bsg - Boseji's Security and Privacy Utilities
+//
+// A collection of Security and Privacy utilities and some notes for help.
+//
+// This is **Golang** package collection as well as few utility
+// command line programs.
+//
+// Sources
+// -------
+// https://github.com/boseji/bsg
+//
+// License
+// -------
+//
+//   bsg - Boseji's Security and Privacy Utilities.
+//   Copyright (C) 2025-2026 by Abhijit Bose (aka. Boseji)
+//
+//   This program is free software: you can redistribute it and/or modify
+//   it under the terms of the GNU General Public License version 2 only
+//   as published by the Free Software Foundation.
+//
+//   This program is distributed in the hope that it will be useful,
+//   but WITHOUT ANY WARRANTY; without even the implied warranty of
+//   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+//
+//   You should have received a copy of the GNU General Public License
+//   along with this program. If not, see <https://www.gnu.org/licenses/>.
+//
+//  SPDX-License-Identifier: GPL-2.0-only
+//  Full Name: GNU General Public License v2.0 only
+//  Please visit <https://spdx.org/licenses/GPL-2.0-only.html> for details.
+//
+
+package gen_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/boseji/bsg/gen"
+)
+
+func TestParseAlgorithm(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+		size     int
+	}{
+		{"canonical", "sha256", "sha256", 32},
+		{"alias", "SHA-256", "sha256", 32},
+		{"sha2 alias", "sha2-512", "sha512", 64},
+		{"sha384", "sha384", "sha384", 48},
+		{"sha1", "sha-1", "sha1", 20},
+		{"sha3", "sha3-512", "sha3-512", 64},
+		{"sha3 underscore", "SHA3_256", "sha3-256", 32},
+		{"shake default", "shake256", "shake256/64", 64},
+		{"shake sized", "shake256/16", "shake256/16", 16},
+		{"shake alias", "shake-128/20", "shake128/20", 20},
+		{"fixed with matching size", "sha256/32", "sha256", 32},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			alg, err := gen.ParseAlgorithm(tt.input)
+			if err != nil {
+				t.Fatalf("ParseAlgorithm(%q) error: %v", tt.input, err)
+			}
+			if alg.Name != tt.expected || alg.Size != tt.size {
+				t.Errorf("ParseAlgorithm(%q) = %s/%d, expected %s/%d",
+					tt.input, alg.Name, alg.Size, tt.expected, tt.size)
+			}
+			if got := len(alg.Sum([]byte("abc"))); got != tt.size {
+				t.Errorf("digest length %d, expected %d", got, tt.size)
+			}
+		})
+	}
+
+	for _, bad := range []string{"", "md5", "sha256/16", "shake256/0", "shake256/x"} {
+		if _, err := gen.ParseAlgorithm(bad); !errors.Is(err, gen.ErrUnknownAlgorithm) {
+			t.Errorf("ParseAlgorithm(%q) error = %v, expected ErrUnknownAlgorithm", bad, err)
+		}
+	}
+}
+
+func TestAlgorithmSums(t *testing.T) {
+	input := []byte("abc")
+	tests := []struct {
+		name     string
+		expected []byte
+	}{
+		{"sha1", gen.SHA1(input)},
+		{"sha256", gen.SHA256(input)},
+		{"sha384", gen.SHA384(input)},
+		{"sha512", gen.SHA512(input)},
+		{"sha3-256", gen.SHA3_256(input)},
+		{"sha3-512", gen.SHA3_512(input)},
+		{"shake128/32", gen.SHAKE128(input, 32)},
+		{"shake256/64", gen.SHAKE256(input, 64)},
+	}
+
+	for _, tt := range tests {
+		alg, err := gen.ParseAlgorithm(tt.name)
+		if err != nil {
+			t.Fatalf("ParseAlgorithm(%q) error: %v", tt.name, err)
+		}
+		if got := alg.Sum(input); !bytes.Equal(got, tt.expected) {
+			t.Errorf("%s: got %x, expected %x", tt.name, got, tt.expected)
+		}
+	}
+}
+
+func TestShakeHashContinues(t *testing.T) {
+	alg, _ := gen.ParseAlgorithm("shake128/32")
+	h := alg.New()
+	h.Write([]byte("ab"))
+	_ = h.Sum(nil)
+	h.Write([]byte("c"))
+	if got := h.Sum(nil); !bytes.Equal(got, gen.SHAKE128([]byte("abc"), 32)) {
+		t.Errorf("Sum disturbed the state: got %x", got)
+	}
+}
+
+func TestDigestString(t *testing.T) {
+	alg, _ := gen.ParseAlgorithm("sha256")
+	d := alg.Digest([]byte("abc"))
+	expected := "sha256:ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad"
+	if d.String() != expected {
+		t.Fatalf("Digest.String() = %s, expected %s", d, expected)
+	}
+
+	parsed, err := gen.ParseDigest("SHA-256:" + strings.ToUpper(expected[7:]))
+	if err != nil {
+		t.Fatalf("ParseDigest error: %v", err)
+	}
+	if parsed.String() != expected {
+		t.Errorf("ParseDigest round trip = %s, expected %s", parsed, expected)
+	}
+	if !parsed.Verify([]byte("abc")) || parsed.Verify([]byte("abd")) {
+		t.Error("Digest.Verify gave the wrong answer")
+	}
+
+	ok, err := parsed.VerifyReader(context.Background(), strings.NewReader("abc"))
+	if err != nil || !ok {
+		t.Errorf("Digest.VerifyReader = %v, %v; expected true", ok, err)
+	}
+
+	ok, err = gen.VerifyDigest(expected, []byte("abc"))
+	if err != nil || !ok {
+		t.Errorf("VerifyDigest = %v, %v; expected true", ok, err)
+	}
+}
+
+func TestParseDigestShake(t *testing.T) {
+	sum := gen.SHAKE256([]byte("abc"), 16)
+	d, err := gen.ParseDigest("shake256:" + gen.Hex(sum))
+	if err != nil {
+		t.Fatalf("ParseDigest error: %v", err)
+	}
+	if d.Algorithm.Name != "shake256/16" {
+		t.Errorf("expected shake256/16, got %s", d.Algorithm.Name)
+	}
+	if !d.Verify([]byte("abc")) {
+		t.Error("SHAKE digest failed to verify")
+	}
+}
+
+func TestParseDigestInvalid(t *testing.T) {
+	tests := []struct {
+		input string
+		err   error
+	}{
+		{"ba7816bf", gen.ErrInvalidDigest},
+		{"sha256:zz", gen.ErrInvalidDigest},
+		{"sha256:abcd", gen.ErrInvalidDigest},
+		{"md5:900150983cd24fb0d6963f7d28e17f72", gen.ErrUnknownAlgorithm},
+	}
+	for _, tt := range tests {
+		if _, err := gen.ParseDigest(tt.input); !errors.Is(err, tt.err) {
+			t.Errorf("ParseDigest(%q) error = %v, expected %v", tt.input, err, tt.err)
+		}
+	}
+}
+
+func TestMultihash(t *testing.T) {
+	alg, _ := gen.ParseAlgorithm("sha256")
+	d := alg.Digest([]byte("abc"))
+	mh, err := d.Multihash()
+	if err != nil {
+		t.Fatalf("Multihash error: %v", err)
+	}
+	if mh[0] != 0x12 || mh[1] != 32 || len(mh) != 34 {
+		t.Errorf("unexpected multihash prefix % x", mh[:2])
+	}
+
+	back, err := gen.ParseMultihash(mh)
+	if err != nil {
+		t.Fatalf("ParseMultihash error: %v", err)
+	}
+	if back.String() != d.String() {
+		t.Errorf("multihash round trip = %s, expected %s", back, d)
+	}
+
+	shake, _ := gen.ParseAlgorithm("shake128/20")
+	mh, _ = shake.Digest([]byte("abc")).Multihash()
+	back, err = gen.ParseMultihash(mh)
+	if err != nil || back.Algorithm.Name != "shake128/20" {
+		t.Errorf("SHAKE multihash round trip = %v, %v", back.Algorithm.Name, err)
+	}
+
+	if _, err := gen.ParseMultihash(mh[:len(mh)-1]); !errors.Is(err, gen.ErrInvalidDigest) {
+		t.Errorf("expected ErrInvalidDigest for truncated multihash, got %v", err)
+	}
+}
+
+func TestRegisterAlgorithm(t *testing.T) {
+	err := gen.RegisterAlgorithm(gen.Algorithm{
+		Name: "MD5-Test", Size: md5.Size, New: md5.New,
+	}, "md5test-alias")
+	if err != nil {
+		t.Fatalf("RegisterAlgorithm error: %v", err)
+	}
+	alg, err := gen.ParseAlgorithm("md5test-alias")
+	if err != nil || alg.Name != "md5-test" {
+		t.Errorf("ParseAlgorithm after register = %v, %v", alg.Name, err)
+	}
+
+	if err := gen.RegisterAlgorithm(gen.Algorithm{
+		Name: "sha256", Size: md5.Size, New: md5.New,
+	}); err == nil {
+		t.Error("expected error re-registering sha256")
+	}
+	if err := gen.RegisterAlgorithm(gen.Algorithm{
+		Name: "dup-code", Size: md5.Size, Code: 0x12, New: md5.New,
+	}); err == nil {
+		t.Error("expected error for duplicate multihash code")
+	}
+
+	found := false
+	for _, n := range gen.Algorithms() {
+		if n == "md5-test" {
+			found = true
+		}
+	}
+	if !found {
+		t.Error("Algorithms() does not list the registered algorithm")
+	}
+}
@@ -49,7 +49,14 @@
 package gen
 
 import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"errors"
 	"fmt"
+	"hash"
+	"strings"
 
 	"golang.org/x/crypto/bcrypt"
 )
@@ -59,32 +66,199 @@ const (
 	NominalBcryptCost int = bcrypt.DefaultCost
 	MinBcryptCost     int = bcrypt.MinCost
 	MaxBcryptCost     int = bcrypt.MaxCost
+
+	// MaxBcryptPassword is the longest password in bytes that Bcrypt
+	// can work on. Anything longer needs the pre-hashed mode.
+	MaxBcryptPassword int = 72
+)
+
+// Markers placed in front of a regular Bcrypt hash to identify the
+// pre-hashed form, e.g. "$bsg-sha384$2a$12$...".
+const (
+	BcryptMarkerSHA256 = "$bsg-sha256"
+	BcryptMarkerSHA384 = "$bsg-sha384"
+)
+
+// bcryptPreHashKey is the fixed HMAC key used to domain separate the
+// pre-hashed passwords from a plain SHA digest of the same.
+const bcryptPreHashKey = "bsg/gen bcrypt pre-hash v1"
+
+var (
+	ErrBcryptTooLong  = errors.New("bcrypt: password exceeds 72 bytes")
+	ErrBcryptNulByte  = errors.New("bcrypt: password contains NUL byte")
+	ErrBcryptMismatch = errors.New("bcrypt: password does not match hash")
+	ErrBcryptPreHash  = errors.New("bcrypt: unknown pre-hash")
+)
+
+// BcryptPreHash selects the digest used in the pre-hashed Bcrypt mode.
+type BcryptPreHash int
+
+const (
+	BcryptSHA256 BcryptPreHash = iota + 1
+	BcryptSHA384
 )
 
 // BcryptHash helps to Hash a password using the Bcrypt Algorithm
 // With a pre-determined cost value.
 func BcryptHash(password string) (string, error) {
 	// DefaultCost = 10 (adjust higher for slower hashing)
-	bytes, err := bcrypt.GenerateFromPassword([]byte(password),
-		DefaultBcryptCost)
-	return string(bytes), err
+	return BcryptHashC(password, DefaultBcryptCost)
 }
 
 // BcryptHashC helps to Hash a password using the Bcrypt Algorithm
 // With a supplied cost value.
 func BcryptHashC(password string, cost int) (string, error) {
+	return bcryptHash([]byte(password), cost)
+}
+
+// BcryptHashSecret is BcryptHash for a password held in a Secret.
+func BcryptHashSecret(password *Secret) (string, error) {
+	var hash string
+	var err error
+	password.use(func(pw []byte) { hash, err = bcryptHash(pw, DefaultBcryptCost) })
+	return hash, err
+}
+
+// bcryptHash hashes pw with plain Bcrypt at the given cost.
+func bcryptHash(pw []byte, cost int) (string, error) {
+	if err := bcryptCost(cost); err != nil {
+		return "", err
+	}
+	if err := bcryptPassword(pw); err != nil {
+		return "", err
+	}
+	out, err := bcrypt.GenerateFromPassword(pw, cost)
+	return string(out), err
+}
+
+// BcryptHashPre helps to Hash a password of any length using the
+// pre-hashed Bcrypt mode with HMAC-SHA-384 and the default cost.
+func BcryptHashPre(password string) (string, error) {
+	return BcryptHashPreC(password, DefaultBcryptCost, BcryptSHA384)
+}
+
+// BcryptHashPreC helps to Hash a password of any length using the
+// pre-hashed Bcrypt mode with the supplied cost and digest.
+//
+// The password is first passed through HMAC with the chosen digest and
+// Base64 encoded, which always fits in the 72 byte limit of Bcrypt.
+// The result is prefixed with a marker so that BcryptVerify knows to
+// apply the same pre-hash.
+func BcryptHashPreC(password string, cost int, ph BcryptPreHash) (string, error) {
+	return bcryptHashPre([]byte(password), cost, ph)
+}
+
+// BcryptHashPreSecret is BcryptHashPre for a password held in a Secret.
+func BcryptHashPreSecret(password *Secret) (string, error) {
+	var hash string
+	var err error
+	password.use(func(pw []byte) {
+		hash, err = bcryptHashPre(pw, DefaultBcryptCost, BcryptSHA384)
+	})
+	return hash, err
+}
+
+// bcryptHashPre hashes pw with pre-hashed Bcrypt.
+func bcryptHashPre(pw []byte, cost int, ph BcryptPreHash) (string, error) {
+	if err := bcryptCost(cost); err != nil {
+		return "", err
+	}
+	marker, pre, err := bcryptPreHash(ph, pw)
+	if err != nil {
+		return "", err
+	}
+	defer clear(pre)
+	out, err := bcrypt.GenerateFromPassword(pre, cost)
+	if err != nil {
+		return "", err
+	}
+	return marker + string(out), nil
+}
+
+// BcryptVerify checks the password against either a plain or a
+// pre-hashed Bcrypt hash. It returns nil on a match and an error
+// explaining the failure otherwise.
+func BcryptVerify(password, hash string) error {
+	return bcryptVerify([]byte(password), hash)
+}
+
+// BcryptVerifySecret is BcryptVerify for a password held in a Secret.
+func BcryptVerifySecret(password *Secret, hash string) error {
+	var err error
+	password.use(func(pw []byte) { err = bcryptVerify(pw, hash) })
+	return err
+}
+
+// bcryptVerify checks pw against a plain or pre-hashed hash.
+func bcryptVerify(pw []byte, hash string) error {
+	switch {
+	case strings.HasPrefix(hash, BcryptMarkerSHA256+"$"):
+		_, pw, _ = bcryptPreHash(BcryptSHA256, pw)
+		defer clear(pw)
+		hash = hash[len(BcryptMarkerSHA256):]
+	case strings.HasPrefix(hash, BcryptMarkerSHA384+"$"):
+		_, pw, _ = bcryptPreHash(BcryptSHA384, pw)
+		defer clear(pw)
+		hash = hash[len(BcryptMarkerSHA384):]
+	case strings.HasPrefix(hash, "$bsg-"):
+		return ErrBcryptPreHash
+	default:
+		if err := bcryptPassword(pw); err != nil {
+			return err
+		}
+	}
+	err := bcrypt.CompareHashAndPassword([]byte(hash), pw)
+	if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+		return ErrBcryptMismatch
+	}
+	return err
+}
+
+// BcryptCheck helps to Verify a password matches into the Hash or not.
+// Both plain and pre-hashed forms are accepted.
+func BcryptCheck(password, hash string) bool {
+	return BcryptVerify(password, hash) == nil
+}
+
+// bcryptCost makes sure the cost is in the range supported by Bcrypt.
+func bcryptCost(cost int) error {
 	if cost < MinBcryptCost {
-		return "", fmt.Errorf("cost too low")
+		return fmt.Errorf("cost too low")
 	}
 	if cost > MaxBcryptCost {
-		return "", fmt.Errorf("cost too low")
+		return fmt.Errorf("cost too high")
 	}
-	bytes, err := bcrypt.GenerateFromPassword([]byte(password), cost)
-	return string(bytes), err
+	return nil
 }
 
-// BcryptCheck helps to Verify a password matches into the Hash or not
-func BcryptCheck(password, hash string) bool {
-	err := bcrypt.CompareHashAndPassword([]byte(hash), []byte(password))
-	return err == nil
+// bcryptPassword rejects the passwords that plain Bcrypt would
+// silently mangle.
+func bcryptPassword(pw []byte) error {
+	if len(pw) > MaxBcryptPassword {
+		return ErrBcryptTooLong
+	}
+	if bytes.IndexByte(pw, 0) >= 0 {
+		return ErrBcryptNulByte
+	}
+	return nil
+}
+
+// bcryptPreHash returns the marker and the Base64 encoded HMAC of the
+// password for the selected digest.
+func bcryptPreHash(ph BcryptPreHash, password []byte) (string, []byte, error) {
+	var marker string
+	var h func() hash.Hash
+	switch ph {
+	case BcryptSHA256:
+		marker, h = BcryptMarkerSHA256, sha256.New
+	case BcryptSHA384:
+		marker, h = BcryptMarkerSHA384, sha512.New384
+	default:
+		return "", nil, ErrBcryptPreHash
+	}
+	sum := HMAC(h, []byte(bcryptPreHashKey), password)
+	defer clear(sum)
+	pre := make([]byte, base64.StdEncoding.EncodedLen(len(sum)))
+	base64.StdEncoding.Encode(pre, sum)
+	return marker, pre, nil
 }
@@ -49,6 +49,8 @@
 package gen
 
 import (
+	"errors"
+	"strings"
 	"testing"
 
 	"golang.org/x/crypto/bcrypt"
@@ -254,3 +256,163 @@ func TestBcryptHashDifferentSalts(t *testing.T) {
 		t.Error("Both hashes should verify with the correct password")
 	}
 }
+
+// TestBcryptLimits tests the explicit errors for unsafe plain passwords
+func TestBcryptLimits(t *testing.T) {
+	tests := []struct {
+		name     string
+		password string
+		wantErr  error
+	}{
+		{
+			name:     "exactly 72 bytes",
+			password: strings.Repeat("a", MaxBcryptPassword),
+			wantErr:  nil,
+		},
+		{
+			name:     "73 bytes",
+			password: strings.Repeat("a", MaxBcryptPassword+1),
+			wantErr:  ErrBcryptTooLong,
+		},
+		{
+			name:     "NUL byte",
+			password: "pass\x00word",
+			wantErr:  ErrBcryptNulByte,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := BcryptHashC(tt.password, MinBcryptCost)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("BcryptHashC() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// TestBcryptHashPre tests the pre-hashed mode with both digests
+func TestBcryptHashPre(t *testing.T) {
+	long := strings.Repeat("correct horse battery staple ", 10)
+
+	tests := []struct {
+		name     string
+		password string
+		ph       BcryptPreHash
+		marker   string
+	}{
+		{
+			name:     "SHA-256 short",
+			password: "mypassword",
+			ph:       BcryptSHA256,
+			marker:   BcryptMarkerSHA256,
+		},
+		{
+			name:     "SHA-384 long",
+			password: long,
+			ph:       BcryptSHA384,
+			marker:   BcryptMarkerSHA384,
+		},
+		{
+			name:     "SHA-384 NUL byte",
+			password: "pass\x00word",
+			ph:       BcryptSHA384,
+			marker:   BcryptMarkerSHA384,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hash, err := BcryptHashPreC(tt.password, MinBcryptCost, tt.ph)
+			if err != nil {
+				t.Fatalf("BcryptHashPreC() error = %v, want nil", err)
+			}
+			if !strings.HasPrefix(hash, tt.marker+"$2a$") {
+				t.Errorf("BcryptHashPreC() = %q, want marker %q", hash, tt.marker)
+			}
+			if err := BcryptVerify(tt.password, hash); err != nil {
+				t.Errorf("BcryptVerify() error = %v, want nil", err)
+			}
+			if !BcryptCheck(tt.password, hash) {
+				t.Error("BcryptCheck() failed to verify the pre-hashed form")
+			}
+		})
+	}
+
+	// Passwords that only differ after 72 bytes must not match
+	hash, err := BcryptHashPreC(long+"1", MinBcryptCost, BcryptSHA384)
+	if err != nil {
+		t.Fatalf("BcryptHashPreC() error = %v, want nil", err)
+	}
+	if err := BcryptVerify(long+"2", hash); !errors.Is(err, ErrBcryptMismatch) {
+		t.Errorf("BcryptVerify() error = %v, want %v", err, ErrBcryptMismatch)
+	}
+
+	if _, err := BcryptHashPreC("x", MinBcryptCost, 0); !errors.Is(err, ErrBcryptPreHash) {
+		t.Errorf("BcryptHashPreC() error = %v, want %v", err, ErrBcryptPreHash)
+	}
+}
+
+// TestBcryptVerify tests that plain and pre-hashed forms are told apart
+func TestBcryptVerify(t *testing.T) {
+	password := "correctpassword"
+	plain, _ := BcryptHashC(password, MinBcryptCost)
+	pre, _ := BcryptHashPreC(password, MinBcryptCost, BcryptSHA256)
+
+	tests := []struct {
+		name     string
+		password string
+		hash     string
+		wantErr  error
+	}{
+		{
+			name:     "plain match",
+			password: password,
+			hash:     plain,
+		},
+		{
+			name:     "pre-hashed match",
+			password: password,
+			hash:     pre,
+		},
+		{
+			name:     "plain mismatch",
+			password: "wrongpassword",
+			hash:     plain,
+			wantErr:  ErrBcryptMismatch,
+		},
+		{
+			name:     "pre-hashed mismatch",
+			password: "wrongpassword",
+			hash:     pre,
+			wantErr:  ErrBcryptMismatch,
+		},
+		{
+			name:     "pre-hashed body used as plain",
+			password: password,
+			hash:     pre[len(BcryptMarkerSHA256):],
+			wantErr:  ErrBcryptMismatch,
+		},
+		{
+			name:     "unknown marker",
+			password: password,
+			hash:     "$bsg-md5" + plain,
+			wantErr:  ErrBcryptPreHash,
+		},
+		{
+			name:     "oversize plain",
+			password: strings.Repeat("a", MaxBcryptPassword+1),
+			hash:     plain,
+			wantErr:  ErrBcryptTooLong,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := BcryptVerify(tt.password, tt.hash)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("BcryptVerify() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,258 @@
+// bech32.go - Part of the `gen` Package
+//
+//     ॐ भूर्भुवः स्वः
+//     तत्स॑वि॒तुर्वरे॑ण्यं॒
+//    भर्गो॑ दे॒वस्य॑ धीमहि।
+//   धियो॒ यो नः॑ प्रचो॒दया॑त्॥
+//
+//
+//  बी.स.जी - बोसजी के द्वारा रचित सुरक्षा एवं गोपनीयता हेतु तन्त्राक्ष्।
+// ================================================
+//
+// एक सुरक्षा एवं गोपनीयता केंद्रित तंत्राक्षों का संकलन।
+//
+// एक रचनात्मक भारतीय उत्पाद ।
+//
+// bsg - Boseji's Security and Privacy Utilities
+//
+// A collection of Security and Privacy utilities and some notes for help.
+//
+// This is **Golang** package collection as well as few utility
+// command line programs.
+//
+// Sources
+// -------
+// https://github.com/boseji/bsg
+//
+// License
+// -------
+//
+//   bsg - Boseji's Security and Privacy Utilities.
+//   Copyright (C) 2025-2026 by Abhijit Bose (aka. Boseji)
+//
+//   This program is free software: you can redistribute it and/or modify
+//   it under the terms of the GNU General Public License version 2 only
+//   as published by the Free Software Foundation.
+//
+//   This program is distributed in the hope that it will be useful,
+//   but WITHOUT ANY WARRANTY; without even the implied warranty of
+//   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+//
+//   You should have received a copy of the GNU General Public License
+//   along with this program. If not, see <https://www.gnu.org/licenses/>.
+//
+//  SPDX-License-Identifier: GPL-2.0-only
+//  Full Name: GNU General Public License v2.0 only
+//  Please visit <https://spdx.org/licenses/GPL-2.0-only.html> for details.
+//
+
+package gen
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Bech32Variant selects the checksum constant of a Bech32 string.
+type Bech32Variant int
+
+const (
+	Bech32  Bech32Variant = iota + 1 // BIP-173 checksum.
+	Bech32m                          // BIP-350 checksum.
+)
+
+// bech32MaxLength is the longest Bech32 string allowed by BIP-173.
+const bech32MaxLength = 90
+
+// bech32Alphabet maps 5 bit values to Bech32 characters.
+const bech32Alphabet = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+
+// String returns the variant name.
+func (v Bech32Variant) String() string {
+	switch v {
+	case Bech32:
+		return "bech32"
+	case Bech32m:
+		return "bech32m"
+	}
+	return fmt.Sprintf("Bech32Variant(%d)", int(v))
+}
+
+// constant returns the value the checksum polymod must produce.
+func (v Bech32Variant) constant() uint32 {
+	if v == Bech32m {
+		return 0x2bc830a3
+	}
+	return 1
+}
+
+// EncodeBech32 encodes data under the human readable part hrp. The data
+// bytes are regrouped into 5 bit values before the checksum is added.
+// The result is lower case and at most 90 characters long.
+// See https://github.com/bitcoin/bips/blob/master/bip-0173.mediawiki
+func EncodeBech32(hrp string, data []byte, variant Bech32Variant) (string, error) {
+	if variant != Bech32 && variant != Bech32m {
+		return "", fmt.Errorf("%w: unknown Bech32 variant %d", ErrInvalidArgument, variant)
+	}
+	if err := bech32CheckHRP(hrp); err != nil {
+		return "", err
+	}
+	hrp = strings.ToLower(hrp)
+	values := bech32ConvertBits(data, 8, 5, true)
+	if len(hrp)+1+len(values)+6 > bech32MaxLength {
+		return "", fmt.Errorf("%w: Bech32 string longer than %d characters",
+			ErrInvalidEncoding, bech32MaxLength)
+	}
+
+	sum := bech32Polymod(append(bech32ExpandHRP(hrp), append(values,
+		0, 0, 0, 0, 0, 0)...)) ^ variant.constant()
+	var b strings.Builder
+	b.WriteString(hrp)
+	b.WriteByte('1')
+	for _, v := range values {
+		b.WriteByte(bech32Alphabet[v])
+	}
+	for i := 0; i < 6; i++ {
+		b.WriteByte(bech32Alphabet[(sum>>(5*(5-i)))&31])
+	}
+	return b.String(), nil
+}
+
+// DecodeBech32 decodes a Bech32 or Bech32m string into its lower case
+// human readable part, data bytes and the variant of its checksum.
+func DecodeBech32(s string) (string, []byte, Bech32Variant, error) {
+	if len(s) > bech32MaxLength {
+		return "", nil, 0, fmt.Errorf("%w: Bech32 string longer than %d characters",
+			ErrInvalidEncoding, bech32MaxLength)
+	}
+	if strings.ToLower(s) != s && strings.ToUpper(s) != s {
+		return "", nil, 0, fmt.Errorf("%w: Bech32 string has mixed case", ErrInvalidEncoding)
+	}
+	s = strings.ToLower(s)
+	pos := strings.LastIndexByte(s, '1')
+	if pos < 1 || pos+7 > len(s) {
+		return "", nil, 0, fmt.Errorf("%w: Bech32 separator misplaced", ErrInvalidEncoding)
+	}
+	hrp := s[:pos]
+	if err := bech32CheckHRP(hrp); err != nil {
+		return "", nil, 0, err
+	}
+	values := make([]byte, 0, len(s)-pos-1)
+	for i := pos + 1; i < len(s); i++ {
+		d := strings.IndexByte(bech32Alphabet, s[i])
+		if d < 0 {
+			return "", nil, 0, fmt.Errorf("%w: bad Bech32 character %q",
+				ErrInvalidEncoding, s[i])
+		}
+		values = append(values, byte(d))
+	}
+
+	var variant Bech32Variant
+	switch bech32Polymod(append(bech32ExpandHRP(hrp), values...)) {
+	case Bech32.constant():
+		variant = Bech32
+	case Bech32m.constant():
+		variant = Bech32m
+	default:
+		return "", nil, 0, fmt.Errorf("%w: Bech32 checksum mismatch", ErrInvalidEncoding)
+	}
+
+	data, ok := bech32RegroupBits(values[:len(values)-6])
+	if !ok {
+		return "", nil, 0, fmt.Errorf("%w: Bech32 data has bad padding", ErrInvalidEncoding)
+	}
+	return hrp, data, variant, nil
+}
+
+// bech32CheckHRP rejects an empty human readable part or one with
+// characters outside ASCII 33 to 126.
+func bech32CheckHRP(hrp string) error {
+	if len(hrp) == 0 || len(hrp) > bech32MaxLength-7 {
+		return fmt.Errorf("%w: Bech32 prefix must be 1 to %d characters",
+			ErrInvalidEncoding, bech32MaxLength-7)
+	}
+	for i := 0; i < len(hrp); i++ {
+		if hrp[i] < 33 || hrp[i] > 126 {
+			return fmt.Errorf("%w: bad Bech32 prefix character %q",
+				ErrInvalidEncoding, hrp[i])
+		}
+	}
+	return nil
+}
+
+// bech32Polymod computes the BCH checksum over 5 bit values.
+func bech32Polymod(values []byte) uint32 {
+	gen := [5]uint32{0x3b6a57b2, 0x26508e6d, 0x1ea119fa, 0x3d4233dd, 0x2a1462b3}
+	chk := uint32(1)
+	for _, v := range values {
+		top := chk >> 25
+		chk = (chk&0x1ffffff)<<5 ^ uint32(v)
+		for i := 0; i < 5; i++ {
+			if (top>>i)&1 == 1 {
+				chk ^= gen[i]
+			}
+		}
+	}
+	return chk
+}
+
+// bech32ExpandHRP spreads the human readable part for the checksum.
+func bech32ExpandHRP(hrp string) []byte {
+	out := make([]byte, 0, len(hrp)*2+1)
+	for i := 0; i < len(hrp); i++ {
+		out = append(out, hrp[i]>>5)
+	}
+	out = append(out, 0)
+	for i := 0; i < len(hrp); i++ {
+		out = append(out, hrp[i]&31)
+	}
+	return out
+}
+
+// bech32ConvertBits regroups values of from bits into values of to bits,
+// zero padding the final group when pad is set.
+func bech32ConvertBits(data []byte, from, to uint, pad bool) []byte {
+	var acc uint32
+	var bits uint
+	maxv := uint32(1)<<to - 1
+	out := make([]byte, 0, (uint(len(data))*from+to-1)/to)
+	for _, v := range data {
+		acc = acc<<from | uint32(v)
+		bits += from
+		for bits >= to {
+			bits -= to
+			out = append(out, byte((acc>>bits)&maxv))
+		}
+	}
+	if pad && bits > 0 {
+		out = append(out, byte((acc<<(to-bits))&maxv))
+	}
+	return out
+}
+
+// bech32RegroupBits turns 5 bit values back into bytes. The padding left
+// over must be shorter than 5 bits and all zero.
+func bech32RegroupBits(values []byte) ([]byte, bool) {
+	out := bech32ConvertBits(values, 5, 8, false)
+	bits := uint(len(values)) * 5 % 8
+	if bits >= 5 {
+		return nil, false
+	}
+	if len(values) > 0 && values[len(values)-1]&(1<<bits-1) != 0 {
+		return nil, false
+	}
+	return out, true
+}
+
+// TokenBech32 generates n secure random bytes and encodes them as
+// Bech32m under the human readable part hrp.
+func TokenBech32(hrp string, n int) (string, error) {
+	if n <= 0 {
+		return "", fmt.Errorf("TokenBech32: %w: n must be > 0", ErrInvalidArgument)
+	}
+	b := make([]byte, n)
+	if _, err := defaultGenerator.Read(b); err != nil {
+		return "", err
+	}
+	return EncodeBech32(hrp, b, Bech32m)
+}
@@ -0,0 +1,486 @@
+// cert.go - Part of the `gen` Package
+//
+//     ॐ भूर्भुवः स्वः
+//     तत्स॑वि॒तुर्वरे॑ण्यं॒
+//    भर्गो॑ दे॒वस्य॑ धीमहि।
+//   धियो॒ यो नः॑ प्रचो॒दया॑त्॥
+//
+//
+//  बी.स.जी - बोसजी के द्वारा रचित सुरक्षा एवं गोपनीयता हेतु तन्त्राक्ष्।
+// ================================================
+//
+// एक सुरक्षा एवं गोपनीयता केंद्रित तंत्राक्षों का संकलन।
+//
+// एक रचनात्मक भारतीय उत्पाद ।
+//
+// bsg - Boseji's Security and Privacy Utilities
+//
+// A collection of Security and Privacy utilities and some notes for help.
+//
+// This is **Golang** package collection as well as few utility
+// command line programs.
+//
+// Sources
+// -------
+// https://github.com/boseji/bsg
+//
+// License
+// -------
+//
+//   bsg - Boseji's Security and Privacy Utilities.
+//   Copyright (C) 2025-2026 by Abhijit Bose (aka. Boseji)
+//
+//   This program is free software: you can redistribute it and/or modify
+//   it under the terms of the GNU General Public License version 2 only
+//   as published by the Free Software Foundation.
+//
+//   This program is distributed in the hope that it will be useful,
+//   but WITHOUT ANY WARRANTY; without even the implied warranty of
+//   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+//
+//   You should have received a copy of the GNU General Public License
+//   along with this program. If not, see <https://www.gnu.org/licenses/>.
+//
+//  SPDX-License-Identifier: GPL-2.0-only
+//  Full Name: GNU General Public License v2.0 only
+//  Please visit <https://spdx.org/licenses/GPL-2.0-only.html> for details.
+//
+
+package gen
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// KeyType selects the key algorithm of a certificate.
+type KeyType int
+
+const (
+	KeyECDSAP256 KeyType = iota // ECDSA on NIST P-256.
+	KeyEd25519                  // Ed25519.
+)
+
+const (
+	// DefaultCAValidity is how long a new CA stays valid.
+	DefaultCAValidity = 10 * 365 * 24 * time.Hour
+
+	// DefaultCertValidity is how long an issued certificate stays valid.
+	DefaultCertValidity = 90 * 24 * time.Hour
+
+	// certBackdate allows for clocks that run slightly behind.
+	certBackdate = 5 * time.Minute
+)
+
+var (
+	ErrNoHosts        = errors.New("cert: at least one host is required")
+	ErrInvalidCertPEM = errors.New("cert: invalid PEM data")
+	ErrKeyMismatch    = errors.New("cert: private key does not match certificate")
+)
+
+// CertOptions holds the parameters of a new certificate.
+type CertOptions struct {
+	KeyType      KeyType       // Key algorithm (default is KeyECDSAP256).
+	Validity     time.Duration // Lifetime (defaults per certificate kind).
+	Organization string        // Subject organization (default is "bsg").
+	Now          time.Time     // Start of validity (default is time.Now()).
+}
+
+// CertOption is a functional option for NewCA and Issue.
+type CertOption func(*CertOptions)
+
+// WithKeyType selects the key algorithm.
+func WithKeyType(t KeyType) CertOption {
+	return func(o *CertOptions) {
+		o.KeyType = t
+	}
+}
+
+// WithValidity sets the certificate lifetime.
+func WithValidity(d time.Duration) CertOption {
+	return func(o *CertOptions) {
+		o.Validity = d
+	}
+}
+
+// WithOrganization sets the subject organization.
+func WithOrganization(org string) CertOption {
+	return func(o *CertOptions) {
+		o.Organization = org
+	}
+}
+
+// WithNow sets the start of validity, mainly for tests.
+func WithNow(t time.Time) CertOption {
+	return func(o *CertOptions) {
+		o.Now = t
+	}
+}
+
+// certOptions applies opts over the defaults.
+func certOptions(validity time.Duration, opts []CertOption) *CertOptions {
+	o := &CertOptions{Validity: validity, Organization: "bsg", Now: time.Now()}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+// Certificate is a parsed certificate with its private key.
+type Certificate struct {
+	Cert *x509.Certificate
+	Key  crypto.Signer
+}
+
+// CA is a local certificate authority.
+type CA struct {
+	Certificate
+}
+
+// NewCA creates a self-signed certificate authority that may only
+// issue leaf certificates.
+func NewCA(commonName string, opts ...CertOption) (*CA, error) {
+	o := certOptions(DefaultCAValidity, opts)
+	key, err := newCertKey(o.KeyType)
+	if err != nil {
+		return nil, err
+	}
+	tmpl, err := certTemplate(commonName, o)
+	if err != nil {
+		return nil, err
+	}
+	tmpl.IsCA = true
+	tmpl.BasicConstraintsValid = true
+	tmpl.MaxPathLenZero = true
+	tmpl.KeyUsage = x509.KeyUsageCertSign | x509.KeyUsageCRLSign | x509.KeyUsageDigitalSignature
+
+	c, err := createCert(tmpl, tmpl, key.Public(), key)
+	if err != nil {
+		return nil, err
+	}
+	return &CA{Certificate{Cert: c, Key: key}}, nil
+}
+
+// Issue creates a leaf certificate for the hosts, each a DNS name or an
+// IP address. The first host becomes the common name. The certificate
+// is valid for both server and client authentication.
+func (ca *CA) Issue(hosts []string, opts ...CertOption) (*Certificate, error) {
+	if len(hosts) == 0 {
+		return nil, ErrNoHosts
+	}
+	o := certOptions(DefaultCertValidity, opts)
+	key, err := newCertKey(o.KeyType)
+	if err != nil {
+		return nil, err
+	}
+	tmpl, err := certTemplate(hosts[0], o)
+	if err != nil {
+		return nil, err
+	}
+	for _, h := range hosts {
+		if ip := net.ParseIP(h); ip != nil {
+			tmpl.IPAddresses = append(tmpl.IPAddresses, ip)
+		} else {
+			tmpl.DNSNames = append(tmpl.DNSNames, h)
+		}
+	}
+	tmpl.KeyUsage = x509.KeyUsageDigitalSignature
+	if o.KeyType == KeyECDSAP256 {
+		tmpl.KeyUsage |= x509.KeyUsageKeyAgreement
+	}
+	tmpl.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth,
+		x509.ExtKeyUsageClientAuth}
+	if tmpl.NotAfter.After(ca.Cert.NotAfter) {
+		tmpl.NotAfter = ca.Cert.NotAfter
+	}
+
+	c, err := createCert(tmpl, ca.Cert, key.Public(), ca.Key)
+	if err != nil {
+		return nil, err
+	}
+	return &Certificate{Cert: c, Key: key}, nil
+}
+
+// Pool returns a certificate pool holding only the CA.
+func (ca *CA) Pool() *x509.CertPool {
+	p := x509.NewCertPool()
+	p.AddCert(ca.Cert)
+	return p
+}
+
+// TLSConfig issues a certificate for the hosts and returns a server
+// config that presents it and a client config that trusts only the CA.
+// It is meant for tests and local services.
+func (ca *CA) TLSConfig(hosts ...string) (server, client *tls.Config, err error) {
+	leaf, err := ca.Issue(hosts)
+	if err != nil {
+		return nil, nil, err
+	}
+	cert, err := leaf.TLSCertificate()
+	if err != nil {
+		return nil, nil, err
+	}
+	server = &tls.Config{Certificates: []tls.Certificate{cert},
+		MinVersion: tls.VersionTLS12}
+	client = &tls.Config{RootCAs: ca.Pool(), ServerName: hosts[0],
+		MinVersion: tls.VersionTLS12}
+	return server, client, nil
+}
+
+// TLSCertificate returns the certificate and key for crypto/tls.
+func (c *Certificate) TLSCertificate() (tls.Certificate, error) {
+	return tls.Certificate{Certificate: [][]byte{c.Cert.Raw}, PrivateKey: c.Key,
+		Leaf: c.Cert}, nil
+}
+
+// CertPEM returns the certificate as a PEM "CERTIFICATE" block.
+func (c *Certificate) CertPEM() []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: c.Cert.Raw})
+}
+
+// KeyPEM returns the private key as a PKCS #8 "PRIVATE KEY" block.
+func (c *Certificate) KeyPEM() ([]byte, error) {
+	der, err := x509.MarshalPKCS8PrivateKey(c.Key)
+	if err != nil {
+		return nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), nil
+}
+
+// WriteFiles writes the certificate and key as PEM files. The key file
+// is created with mode 0600 and the certificate with mode 0644; existing
+// files are truncated and get those modes.
+func (c *Certificate) WriteFiles(certPath, keyPath string) error {
+	key, err := c.KeyPEM()
+	if err != nil {
+		return err
+	}
+	defer clear(key)
+	if err := writeFileMode(keyPath, key, 0o600); err != nil {
+		return err
+	}
+	return writeFileMode(certPath, c.CertPEM(), 0o644)
+}
+
+// LoadCertificate reads a PEM certificate and private key pair and
+// checks that they belong together.
+func LoadCertificate(certPath, keyPath string) (*Certificate, error) {
+	certPEM, err := os.ReadFile(certPath)
+	if err != nil {
+		return nil, err
+	}
+	keyPEM, err := os.ReadFile(keyPath)
+	if err != nil {
+		return nil, err
+	}
+	defer clear(keyPEM)
+	return ParseCertificate(certPEM, keyPEM)
+}
+
+// LoadCA reads a CA certificate and key written by WriteFiles.
+func LoadCA(certPath, keyPath string) (*CA, error) {
+	c, err := LoadCertificate(certPath, keyPath)
+	if err != nil {
+		return nil, err
+	}
+	if !c.Cert.IsCA {
+		return nil, fmt.Errorf("cert: %s is not a CA certificate", certPath)
+	}
+	return &CA{*c}, nil
+}
+
+// ParseCertificate decodes a PEM certificate and PKCS #8 private key.
+func ParseCertificate(certPEM, keyPEM []byte) (*Certificate, error) {
+	cb, _ := pem.Decode(certPEM)
+	if cb == nil || cb.Type != "CERTIFICATE" {
+		return nil, fmt.Errorf("%w: no CERTIFICATE block", ErrInvalidCertPEM)
+	}
+	cert, err := x509.ParseCertificate(cb.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	kb, _ := pem.Decode(keyPEM)
+	if kb == nil || kb.Type != "PRIVATE KEY" {
+		return nil, fmt.Errorf("%w: no PRIVATE KEY block", ErrInvalidCertPEM)
+	}
+	k, err := x509.ParsePKCS8PrivateKey(kb.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	signer, ok := k.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("%w: unsupported key type %T", ErrInvalidCertPEM, k)
+	}
+	pub, ok := signer.Public().(interface{ Equal(crypto.PublicKey) bool })
+	if !ok || !pub.Equal(cert.PublicKey) {
+		return nil, ErrKeyMismatch
+	}
+	return &Certificate{Cert: cert, Key: signer}, nil
+}
+
+// CertInfo summarises one certificate found on disk.
+type CertInfo struct {
+	Path      string
+	Subject   string
+	Hosts     []string
+	IsCA      bool
+	NotBefore time.Time
+	NotAfter  time.Time
+}
+
+// Expired reports whether the certificate is outside its validity at t.
+func (i CertInfo) Expired(t time.Time) bool {
+	return t.After(i.NotAfter) || t.Before(i.NotBefore)
+}
+
+// ExpiresWithin reports whether the certificate is expired at t or will
+// expire within d after it.
+func (i CertInfo) ExpiresWithin(d time.Duration, t time.Time) bool {
+	return i.Expired(t) || t.Add(d).After(i.NotAfter)
+}
+
+// ListCertificates reads every certificate in the .pem, .crt and .cer
+// files of dir, sorted by expiry. Files without certificates are
+// skipped.
+func ListCertificates(dir string) ([]CertInfo, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var infos []CertInfo
+	for _, e := range entries {
+		ext := strings.ToLower(filepath.Ext(e.Name()))
+		if e.IsDir() || (ext != ".pem" && ext != ".crt" && ext != ".cer") {
+			continue
+		}
+		path := filepath.Join(dir, e.Name())
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		for {
+			var b *pem.Block
+			b, data = pem.Decode(data)
+			if b == nil {
+				break
+			}
+			if b.Type != "CERTIFICATE" {
+				continue
+			}
+			c, err := x509.ParseCertificate(b.Bytes)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", path, err)
+			}
+			infos = append(infos, certInfo(path, c))
+		}
+	}
+	sort.SliceStable(infos, func(i, j int) bool {
+		return infos[i].NotAfter.Before(infos[j].NotAfter)
+	})
+	return infos, nil
+}
+
+// CheckExpiry returns the certificates in dir that are expired or will
+// expire within d from now.
+func CheckExpiry(dir string, d time.Duration) ([]CertInfo, error) {
+	infos, err := ListCertificates(dir)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	var out []CertInfo
+	for _, i := range infos {
+		if i.ExpiresWithin(d, now) {
+			out = append(out, i)
+		}
+	}
+	return out, nil
+}
+
+// certInfo summarises c.
+func certInfo(path string, c *x509.Certificate) CertInfo {
+	hosts := append([]string{}, c.DNSNames...)
+	for _, ip := range c.IPAddresses {
+		hosts = append(hosts, ip.String())
+	}
+	return CertInfo{Path: path, Subject: c.Subject.String(), Hosts: hosts,
+		IsCA: c.IsCA, NotBefore: c.NotBefore, NotAfter: c.NotAfter}
+}
+
+// newCertKey generates a private key of type t.
+func newCertKey(t KeyType) (crypto.Signer, error) {
+	switch t {
+	case KeyECDSAP256:
+		return ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	case KeyEd25519:
+		_, k, err := ed25519.GenerateKey(rand.Reader)
+		return k, err
+	}
+	return nil, fmt.Errorf("cert: %w: unknown key type %d", ErrInvalidArgument, t)
+}
+
+// certTemplate fills the fields common to every certificate.
+func certTemplate(commonName string, o *CertOptions) (*x509.Certificate, error) {
+	if o.Validity <= 0 {
+		return nil, fmt.Errorf("cert: %w: validity must be > 0", ErrInvalidArgument)
+	}
+	var b [16]byte
+	if _, err := defaultGenerator.Read(b[:]); err != nil {
+		return nil, err
+	}
+	b[0] &= 0x7f // serial numbers must be positive
+	b[0] |= 0x40 // and keep their full length
+	return &x509.Certificate{
+		SerialNumber: new(big.Int).SetBytes(b[:]),
+		Subject: pkix.Name{
+			CommonName:   commonName,
+			Organization: []string{o.Organization},
+		},
+		NotBefore: o.Now.Add(-certBackdate),
+		NotAfter:  o.Now.Add(o.Validity),
+	}, nil
+}
+
+// createCert signs tmpl with the parent's key and parses the result.
+func createCert(tmpl, parent *x509.Certificate, pub crypto.PublicKey,
+	signer crypto.Signer) (*x509.Certificate, error) {
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, parent, pub, signer)
+	if err != nil {
+		return nil, err
+	}
+	return x509.ParseCertificate(der)
+}
+
+// writeFileMode writes data to path and sets its mode even when the file
+// already existed.
+func writeFileMode(path string, data []byte, perm os.FileMode) error {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, perm)
+	if err != nil {
+		return err
+	}
+	if err := f.Chmod(perm); err != nil {
+		f.Close()
+		return err
+	}
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
@@ -0,0 +1,210 @@
+// cert_test.go - Test Program `gen` Package
+//
+//     ॐ भूर्भुवः स्वः
+//     तत्स॑वि॒तुर्वरे॑ण्यं॒
+//    भर्गो॑ दे॒वस्य॑ धीमहि।
+//   धियो॒ यो नः॑ प्रचो॒दया॑त्॥
+//
+//
+//  बी.स.जी - बोसजी के द्वारा रचित सुरक्षा एवं गोपनीयता हेतु तन्त्राक्ष्।
+// ================================================
+//
+// एक सुरक्षा एवं गोपनीयता केंद्रित तंत्राक्षों का संकलन।
+//
+// एक रचनात्मक भारतीय उत्पाद ।
+//
+// bsg - Boseji's Security and Privacy Utilities
+//
+// A collection of Security and Privacy utilities and some notes for help.
+//
+// This is **Golang** package collection as well as few utility
+// command line programs.
+//
+// Sources
+// -------
+// https://github.com/boseji/bsg
+//
+// License
+// -------
+//
+//   bsg - Boseji's Security and Privacy Utilities.
+//   Copyright (C) 2025-2026 by Abhijit Bose (aka. Boseji)
+//
+//   This program is free software: you can redistribute it and/or modify
+//   it under the terms of the GNU General Public License version 2 only
+//   as published by the Free Software Foundation.
+//
+//   This program is distributed in the hope that it will be useful,
+//   but WITHOUT ANY WARRANTY; without even the implied warranty of
+//   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+//
+//   You should have received a copy of the GNU General Public License
+//   along with this program. If not, see <https://www.gnu.org/licenses/>.
+//
+//  SPDX-License-Identifier: GPL-2.0-only
+//  Full Name: GNU General Public License v2.0 only
+//  Please visit <https://spdx.org/licenses/GPL-2.0-only.html> for details.
+//
+
+package gen_test
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/boseji/bsg/gen"
+)
+
+func TestCAIssue(t *testing.T) {
+	for _, kt := range []gen.KeyType{gen.KeyECDSAP256, gen.KeyEd25519} {
+		ca, err := gen.NewCA("bsg test CA", gen.WithKeyType(kt))
+		if err != nil {
+			t.Fatalf("NewCA(%d): %v", kt, err)
+		}
+		if !ca.Cert.IsCA || !ca.Cert.MaxPathLenZero {
+			t.Fatalf("CA flags: IsCA=%v MaxPathLenZero=%v", ca.Cert.IsCA, ca.Cert.MaxPathLenZero)
+		}
+		leaf, err := ca.Issue([]string{"localhost", "127.0.0.1", "::1"}, gen.WithKeyType(kt))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if leaf.Cert.Subject.CommonName != "localhost" || len(leaf.Cert.DNSNames) != 1 ||
+			len(leaf.Cert.IPAddresses) != 2 {
+			t.Fatalf("SANs: %v %v", leaf.Cert.DNSNames, leaf.Cert.IPAddresses)
+		}
+		for _, host := range []string{"localhost", "127.0.0.1", "::1"} {
+			_, err := leaf.Cert.Verify(x509.VerifyOptions{Roots: ca.Pool(), DNSName: host})
+			if err != nil {
+				t.Errorf("verify %s: %v", host, err)
+			}
+		}
+		if _, err := leaf.Cert.Verify(x509.VerifyOptions{Roots: ca.Pool(),
+			DNSName: "example.com"}); err == nil {
+			t.Error("certificate verified for a host it does not name")
+		}
+	}
+
+	ca, _ := gen.NewCA("short", gen.WithValidity(time.Hour))
+	leaf, _ := ca.Issue([]string{"a.test"})
+	if leaf.Cert.NotAfter.After(ca.Cert.NotAfter) {
+		t.Error("leaf outlives its CA")
+	}
+	if _, err := ca.Issue(nil); !errors.Is(err, gen.ErrNoHosts) {
+		t.Errorf("no hosts: %v", err)
+	}
+	if _, err := gen.NewCA("x", gen.WithKeyType(9)); !errors.Is(err, gen.ErrInvalidArgument) {
+		t.Errorf("bad key type: %v", err)
+	}
+}
+
+func TestCATLSConfig(t *testing.T) {
+	ca, err := gen.NewCA("bsg test CA")
+	if err != nil {
+		t.Fatal(err)
+	}
+	server, client, err := ca.TLSConfig("localhost")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ln, err := tls.Listen("tcp", "127.0.0.1:0", server)
+	if err != nil {
+		t.Skipf("no loopback listener: %v", err)
+	}
+	defer ln.Close()
+	go func() {
+		for {
+			c, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			c.Write([]byte("hello"))
+			c.Close()
+		}
+	}()
+
+	c, err := tls.Dial("tcp", ln.Addr().String(), client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	buf := make([]byte, 5)
+	if _, err := io.ReadFull(c, buf); err != nil || string(buf) != "hello" {
+		t.Fatalf("read %q: %v", buf, err)
+	}
+
+	// A client trusting another CA must refuse the server.
+	other, _ := gen.NewCA("other")
+	_, stranger, _ := other.TLSConfig("localhost")
+	if c, err := tls.Dial("tcp", ln.Addr().String(), stranger); err == nil {
+		c.Close()
+		t.Fatal("handshake with untrusted CA succeeded")
+	}
+}
+
+func TestCertFiles(t *testing.T) {
+	dir := t.TempDir()
+	ca, _ := gen.NewCA("files CA", gen.WithKeyType(gen.KeyEd25519))
+	leaf, _ := ca.Issue([]string{"db.local"})
+
+	caCert, caKey := filepath.Join(dir, "ca.pem"), filepath.Join(dir, "ca.key")
+	leafCert, leafKey := filepath.Join(dir, "db.pem"), filepath.Join(dir, "db.key")
+	// An existing key file with loose permissions gets tightened.
+	os.WriteFile(leafKey, []byte("old"), 0o644)
+	if err := ca.WriteFiles(caCert, caKey); err != nil {
+		t.Fatal(err)
+	}
+	if err := leaf.WriteFiles(leafCert, leafKey); err != nil {
+		t.Fatal(err)
+	}
+	if runtime.GOOS != "windows" {
+		for _, p := range []string{caKey, leafKey} {
+			st, _ := os.Stat(p)
+			if st.Mode().Perm() != 0o600 {
+				t.Errorf("%s mode %v, want 0600", p, st.Mode().Perm())
+			}
+		}
+	}
+
+	ca2, err := gen.LoadCA(caCert, caKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ca2.Cert.Equal(ca.Cert) {
+		t.Fatal("loaded CA differs")
+	}
+	if _, err := gen.LoadCA(leafCert, leafKey); err == nil {
+		t.Error("leaf loaded as CA")
+	}
+	if _, err := gen.LoadCertificate(leafCert, caKey); !errors.Is(err, gen.ErrKeyMismatch) {
+		t.Errorf("mismatched key: %v", err)
+	}
+
+	// Issue from the reloaded CA and check expiry reporting.
+	now := time.Now()
+	old, _ := ca2.Issue([]string{"old.local"}, gen.WithNow(now.Add(-100*24*time.Hour)))
+	soon, _ := ca2.Issue([]string{"soon.local"}, gen.WithValidity(48*time.Hour))
+	old.WriteFiles(filepath.Join(dir, "old.crt"), filepath.Join(dir, "old.key"))
+	soon.WriteFiles(filepath.Join(dir, "soon.crt"), filepath.Join(dir, "soon.key"))
+
+	infos, err := gen.ListCertificates(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(infos) != 4 || infos[0].Hosts[0] != "old.local" || !infos[0].Expired(now) {
+		t.Fatalf("ListCertificates = %+v", infos)
+	}
+	expiring, err := gen.CheckExpiry(dir, 7*24*time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(expiring) != 2 || expiring[1].Hosts[0] != "soon.local" {
+		t.Fatalf("CheckExpiry = %+v", expiring)
+	}
+}
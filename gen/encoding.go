@@ -0,0 +1,397 @@
+// encoding.go - Part of the `gen` Package
+//
+//     ॐ भूर्भुवः स्वः
+//     तत्स॑वि॒तुर्वरे॑ण्यं॒
+//    भर्गो॑ दे॒वस्य॑ धीमहि।
+//   धियो॒ यो नः॑ प्रचो॒दया॑त्॥
+//
+//
+//  बी.स.जी - बोसजी के द्वारा रचित सुरक्षा एवं गोपनीयता हेतु तन्त्राक्ष्।
+// ================================================
+//
+// एक सुरक्षा एवं गोपनीयता केंद्रित तंत्राक्षों का संकलन।
+//
+// एक रचनात्मक भारतीय उत्पाद ।
+//
+// bsg - Boseji's Security and Privacy Utilities
+//
+// A collection of Security and Privacy utilities and some notes for help.
+//
+// This is **Golang** package collection as well as few utility
+// command line programs.
+//
+// Sources
+// -------
+// https://github.com/boseji/bsg
+//
+// License
+// -------
+//
+//   bsg - Boseji's Security and Privacy Utilities.
+//   Copyright (C) 2025-2026 by Abhijit Bose (aka. Boseji)
+//
+//   This program is free software: you can redistribute it and/or modify
+//   it under the terms of the GNU General Public License version 2 only
+//   as published by the Free Software Foundation.
+//
+//   This program is distributed in the hope that it will be useful,
+//   but WITHOUT ANY WARRANTY; without even the implied warranty of
+//   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+//
+//   You should have received a copy of the GNU General Public License
+//   along with this program. If not, see <https://www.gnu.org/licenses/>.
+//
+//  SPDX-License-Identifier: GPL-2.0-only
+//  Full Name: GNU General Public License v2.0 only
+//  Please visit <https://spdx.org/licenses/GPL-2.0-only.html> for details.
+//
+
+package gen
+
+import (
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+var ErrInvalidEncoding = errors.New("invalid encoding")
+
+// Encoding selects the text form of a random token.
+type Encoding int
+
+const (
+	EncodingHex       Encoding = iota // Lower case hex.
+	EncodingBase32                    // RFC 4648 base32 without padding.
+	EncodingCrockford                 // Crockford base32.
+	EncodingBase58                    // Base58 with the Bitcoin alphabet.
+	EncodingBase64URL                 // RFC 4648 URL safe base64 without padding.
+	EncodingZ85                       // ZeroMQ Z85, needs a multiple of 4 bytes.
+)
+
+// Token generates n secure random bytes and encodes them.
+func Token(n int, enc Encoding) (string, error) {
+	return TokenFrom(defaultGenerator, n, enc)
+}
+
+// TokenFrom generates n random bytes from the generator and encodes them.
+func TokenFrom(g *Generator, n int, enc Encoding) (string, error) {
+	if n <= 0 {
+		return "", fmt.Errorf("Token: %w: n must be > 0", ErrInvalidArgument)
+	}
+	if enc == EncodingZ85 && n%4 != 0 {
+		return "", fmt.Errorf("Token: %w: Z85 needs a multiple of 4 bytes",
+			ErrInvalidArgument)
+	}
+	b := make([]byte, n)
+	if _, err := g.Read(b); err != nil {
+		return "", err
+	}
+	switch enc {
+	case EncodingHex:
+		return Hex(b), nil
+	case EncodingBase32:
+		return Base32(b), nil
+	case EncodingCrockford:
+		return Crockford(b), nil
+	case EncodingBase58:
+		return Base58(b), nil
+	case EncodingBase64URL:
+		return Base64URL(b), nil
+	case EncodingZ85:
+		return Z85(b)
+	}
+	return "", fmt.Errorf("Token: %w: unknown encoding %d", ErrInvalidArgument, enc)
+}
+
+// base32NoPad is RFC 4648 base32 without padding.
+var base32NoPad = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// Base32 encodes data as RFC 4648 base32 without padding, the form used
+// for TOTP secrets.
+func Base32(data []byte) string {
+	return base32NoPad.EncodeToString(data)
+}
+
+// DecodeBase32 decodes RFC 4648 base32 leniently: white space, lower
+// case and padding are all accepted.
+func DecodeBase32(s string) ([]byte, error) {
+	s = strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) || r == '=' {
+			return -1
+		}
+		return unicode.ToUpper(r)
+	}, s)
+	b, err := base32NoPad.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidEncoding, err)
+	}
+	return b, nil
+}
+
+// crockfordAlphabet is the Crockford base32 alphabet followed by the
+// five extra check symbols.
+const crockfordAlphabet = "0123456789ABCDEFGHJKMNPQRSTVWXYZ*~$=U"
+
+// crockfordNoPad is base32 with the Crockford alphabet.
+var crockfordNoPad = base32.NewEncoding(crockfordAlphabet[:32]).
+	WithPadding(base32.NoPadding)
+
+// Crockford encodes data as Crockford base32.
+func Crockford(data []byte) string {
+	return crockfordNoPad.EncodeToString(data)
+}
+
+// CrockfordCheck encodes data as Crockford base32 followed by the check
+// symbol. As in Crockford's specification the check is the number the
+// encoded symbols stand for modulo 37, so for data whose bits do not fill
+// the last symbol it covers the zero padding bits too.
+func CrockfordCheck(data []byte) string {
+	s := Crockford(data)
+	return s + string(crockfordAlphabet[crockfordMod37(s)])
+}
+
+// DecodeCrockford decodes Crockford base32. Hyphens are ignored, case
+// does not matter and I, L and O are read as 1, 1 and 0.
+func DecodeCrockford(s string) ([]byte, error) {
+	norm, err := crockfordNormalize(s)
+	if err != nil {
+		return nil, err
+	}
+	data, err := crockfordNoPad.DecodeString(norm)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidEncoding, err)
+	}
+	return data, nil
+}
+
+// crockfordNormalize drops hyphens and maps every symbol of s to its
+// upper case form in the alphabet.
+func crockfordNormalize(s string) (string, error) {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c == '-' {
+			continue
+		}
+		v, ok := crockfordValue(c)
+		if !ok {
+			return "", fmt.Errorf("%w: bad Crockford character %q", ErrInvalidEncoding, c)
+		}
+		b.WriteByte(crockfordAlphabet[v])
+	}
+	return b.String(), nil
+}
+
+// DecodeCrockfordCheck decodes Crockford base32 with a trailing check
+// symbol, failing if the symbol does not match.
+func DecodeCrockfordCheck(s string) ([]byte, error) {
+	s = strings.TrimRight(s, "-")
+	if s == "" {
+		return nil, fmt.Errorf("%w: missing check symbol", ErrInvalidEncoding)
+	}
+	check := strings.IndexByte(crockfordAlphabet, upperASCII(s[len(s)-1]))
+	if v, ok := crockfordValue(s[len(s)-1]); ok && check < 0 {
+		check = int(v)
+	}
+	if check < 0 {
+		return nil, fmt.Errorf("%w: bad check symbol", ErrInvalidEncoding)
+	}
+	norm, err := crockfordNormalize(s[:len(s)-1])
+	if err != nil {
+		return nil, err
+	}
+	if crockfordMod37(norm) != check {
+		return nil, fmt.Errorf("%w: check symbol mismatch", ErrInvalidEncoding)
+	}
+	data, err := crockfordNoPad.DecodeString(norm)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidEncoding, err)
+	}
+	return data, nil
+}
+
+// crockfordMod37 returns the number written by the normalized Crockford
+// symbols in s modulo 37.
+func crockfordMod37(s string) int {
+	rem := 0
+	for i := 0; i < len(s); i++ {
+		rem = (rem*32 + strings.IndexByte(crockfordAlphabet[:32], s[i])) % 37
+	}
+	return rem
+}
+
+// upperASCII converts an ASCII letter to upper case.
+func upperASCII(c byte) byte {
+	if c >= 'a' && c <= 'z' {
+		return c - ('a' - 'A')
+	}
+	return c
+}
+
+// base58Alphabet is the Bitcoin Base58 alphabet.
+const base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+// Base58 encodes data with the Bitcoin Base58 alphabet. Each leading
+// zero byte becomes a leading '1'.
+func Base58(data []byte) string {
+	zeros := 0
+	for zeros < len(data) && data[zeros] == 0 {
+		zeros++
+	}
+	// log(256)/log(58) is just under 1.37
+	digits := make([]byte, 0, (len(data)-zeros)*138/100+1)
+	for _, b := range data[zeros:] {
+		carry := int(b)
+		for i := range digits {
+			carry += int(digits[i]) << 8
+			digits[i] = byte(carry % 58)
+			carry /= 58
+		}
+		for carry > 0 {
+			digits = append(digits, byte(carry%58))
+			carry /= 58
+		}
+	}
+
+	out := make([]byte, zeros+len(digits))
+	for i := 0; i < zeros; i++ {
+		out[i] = '1'
+	}
+	for i, d := range digits {
+		out[len(out)-1-i] = base58Alphabet[d]
+	}
+	return string(out)
+}
+
+// DecodeBase58 decodes Bitcoin Base58 text.
+func DecodeBase58(s string) ([]byte, error) {
+	zeros := 0
+	for zeros < len(s) && s[zeros] == '1' {
+		zeros++
+	}
+	bytes := make([]byte, 0, len(s)*733/1000+1)
+	for i := zeros; i < len(s); i++ {
+		carry := strings.IndexByte(base58Alphabet, s[i])
+		if carry < 0 {
+			return nil, fmt.Errorf("%w: bad Base58 character %q", ErrInvalidEncoding, s[i])
+		}
+		for j := range bytes {
+			carry += int(bytes[j]) * 58
+			bytes[j] = byte(carry)
+			carry >>= 8
+		}
+		for carry > 0 {
+			bytes = append(bytes, byte(carry))
+			carry >>= 8
+		}
+	}
+
+	out := make([]byte, zeros+len(bytes))
+	for i, b := range bytes {
+		out[len(out)-1-i] = b
+	}
+	return out, nil
+}
+
+// Base58Check encodes a version byte and payload with a 4 byte double
+// SHA-256 checksum, as used for Bitcoin addresses.
+func Base58Check(version byte, payload []byte) string {
+	b := append([]byte{version}, payload...)
+	return Base58(append(b, base58Checksum(b)...))
+}
+
+// DecodeBase58Check decodes Base58Check text into its version byte and
+// payload, failing if the checksum does not match.
+func DecodeBase58Check(s string) (byte, []byte, error) {
+	b, err := DecodeBase58(s)
+	if err != nil {
+		return 0, nil, err
+	}
+	if len(b) < 5 {
+		return 0, nil, fmt.Errorf("%w: Base58Check too short", ErrInvalidEncoding)
+	}
+	body, sum := b[:len(b)-4], b[len(b)-4:]
+	if !Equal(sum, base58Checksum(body)) {
+		return 0, nil, fmt.Errorf("%w: Base58Check checksum mismatch", ErrInvalidEncoding)
+	}
+	return body[0], body[1:], nil
+}
+
+// base58Checksum returns the first 4 bytes of SHA-256(SHA-256(b)).
+func base58Checksum(b []byte) []byte {
+	first := sha256.Sum256(b)
+	second := sha256.Sum256(first[:])
+	return second[:4]
+}
+
+// Base64URL encodes data as URL safe base64 without padding.
+func Base64URL(data []byte) string {
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// DecodeBase64URL decodes URL safe base64 with or without padding.
+// White space is ignored.
+func DecodeBase64URL(s string) ([]byte, error) {
+	s = strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) || r == '=' {
+			return -1
+		}
+		return r
+	}, s)
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidEncoding, err)
+	}
+	return b, nil
+}
+
+// z85Alphabet is the ZeroMQ Z85 alphabet.
+const z85Alphabet = "0123456789abcdefghijklmnopqrstuvwxyz" +
+	"ABCDEFGHIJKLMNOPQRSTUVWXYZ.-:+=^!/*?&<>()[]{}@%$#"
+
+// Z85 encodes data as ZeroMQ Z85. The length must be a multiple of 4.
+// See https://rfc.zeromq.org/spec/32/
+func Z85(data []byte) (string, error) {
+	if len(data)%4 != 0 {
+		return "", fmt.Errorf("%w: Z85 needs a multiple of 4 bytes", ErrInvalidEncoding)
+	}
+	out := make([]byte, 0, len(data)/4*5)
+	for i := 0; i < len(data); i += 4 {
+		v := uint32(data[i])<<24 | uint32(data[i+1])<<16 |
+			uint32(data[i+2])<<8 | uint32(data[i+3])
+		var chunk [5]byte
+		for j := 4; j >= 0; j-- {
+			chunk[j] = z85Alphabet[v%85]
+			v /= 85
+		}
+		out = append(out, chunk[:]...)
+	}
+	return string(out), nil
+}
+
+// DecodeZ85 decodes ZeroMQ Z85 text. The length must be a multiple of 5.
+func DecodeZ85(s string) ([]byte, error) {
+	if len(s)%5 != 0 {
+		return nil, fmt.Errorf("%w: Z85 needs a multiple of 5 characters", ErrInvalidEncoding)
+	}
+	out := make([]byte, 0, len(s)/5*4)
+	for i := 0; i < len(s); i += 5 {
+		var v uint64
+		for j := 0; j < 5; j++ {
+			d := strings.IndexByte(z85Alphabet, s[i+j])
+			if d < 0 {
+				return nil, fmt.Errorf("%w: bad Z85 character %q", ErrInvalidEncoding, s[i+j])
+			}
+			v = v*85 + uint64(d)
+		}
+		if v > 0xffffffff {
+			return nil, fmt.Errorf("%w: Z85 group overflows", ErrInvalidEncoding)
+		}
+		out = append(out, byte(v>>24), byte(v>>16), byte(v>>8), byte(v))
+	}
+	return out, nil
+}
@@ -0,0 +1,341 @@
+// encoding_test.go - Test Program `gen` Package
+//
+//     ॐ भूर्भुवः स्वः
+//     तत्स॑वि॒तुर्वरे॑ण्यं॒
+//    भर्गो॑ दे॒वस्य॑ धीमहि।
+//   धियो॒ यो नः॑ प्रचो॒दया॑त्॥
+//
+//
+//  बी.स.जी - बोसजी के द्वारा रचित सुरक्षा एवं गोपनीयता हेतु तन्त्राक्ष्।
+// ================================================
+//
+// एक सुरक्षा एवं गोपनीयता केंद्रित तंत्राक्षों का संकलन।
+//
+// एक रचनात्मक भारतीय उत्पाद ।
+//
+// bsg - Boseji's Security and Privacy Utilities
+//
+// A collection of Security and Privacy utilities and some notes for help.
+//
+// This is **Golang** package collection as well as few utility
+// command line programs.
+//
+// Sources
+// -------
+// https://github.com/boseji/bsg
+//
+// License
+// -------
+//
+//   bsg - Boseji's Security and Privacy Utilities.
+//   Copyright (C) 2025-2026 by Abhijit Bose (aka. Boseji)
+//
+//   This program is free software: you can redistribute it and/or modify
+//   it under the terms of the GNU General Public License version 2 only
+//   as published by the Free Software Foundation.
+//
+//   This program is distributed in the hope that it will be useful,
+//   but WITHOUT ANY WARRANTY; without even the implied warranty of
+//   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+//
+//   You should have received a copy of the GNU General Public License
+//   along with this program. If not, see <https://www.gnu.org/licenses/>.
+//
+//  SPDX-License-Identifier: GPL-2.0-only
+//  Full Name: GNU General Public License v2.0 only
+//  Please visit <https://spdx.org/licenses/GPL-2.0-only.html> for details.
+//
+
+package gen_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/boseji/bsg/gen"
+)
+
+func mustHex(t *testing.T, s string) []byte {
+	t.Helper()
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+func TestBase32(t *testing.T) {
+	key := []byte("12345678901234567890")
+	if got := gen.Base32(key); got != "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ" {
+		t.Fatalf("Base32 = %q", got)
+	}
+	tests := []struct {
+		name string
+		in   string
+		ok   bool
+	}{
+		{"canonical", "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ", true},
+		{"lower case", "gezdgnbvgy3tqojqgezdgnbvgy3tqojq", true},
+		{"grouped", "GEZD GNBV GY3T QOJQ\nGEZD GNBV GY3T QOJQ", true},
+		{"padded", " GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ==== ", true},
+		{"bad character", "GEZDGNBVGY3TQOJ1", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := gen.DecodeBase32(tt.in)
+			if !tt.ok {
+				if !errors.Is(err, gen.ErrInvalidEncoding) {
+					t.Fatalf("want ErrInvalidEncoding, got %v", err)
+				}
+				return
+			}
+			if err != nil || !bytes.Equal(got, key) {
+				t.Fatalf("DecodeBase32 = %q, %v", got, err)
+			}
+		})
+	}
+}
+
+func TestCrockford(t *testing.T) {
+	data := []byte("crockford base32")
+	enc := gen.Crockford(data)
+	got, err := gen.DecodeCrockford(strings.ToLower(enc[:8]) + "-" + enc[8:])
+	if err != nil || !bytes.Equal(got, data) {
+		t.Fatalf("DecodeCrockford = %q, %v", got, err)
+	}
+
+	// Aliases decode as their look-alike digits.
+	a, _ := gen.DecodeCrockford("01")
+	b, err := gen.DecodeCrockford("oI")
+	if err != nil || !bytes.Equal(a, b) {
+		t.Fatalf("aliases: %x != %x (%v)", a, b, err)
+	}
+	if _, err := gen.DecodeCrockford("0U"); !errors.Is(err, gen.ErrInvalidEncoding) {
+		t.Fatalf("U must be rejected, got %v", err)
+	}
+
+	// The check symbol is the value of the encoded number modulo 37, as
+	// in encode(n, checksum=True) of the Python base32-crockford package,
+	// e.g. 0x1234 is written "28T0", the number 0x12340 = 74560, and
+	// 74560 mod 37 = 5.
+	tests := []struct {
+		data []byte
+		want string
+	}{
+		{[]byte{0}, "000"},
+		{[]byte{1, 0}, "0400T"},
+		{[]byte{0x12, 0x34}, "28T05"},
+		{[]byte{0x12, 0x34, 0x56, 0x78, 0x9a}, "28T5CY4TY"},
+		{data, "CDS6YRVBCSQQ4S10C9GQ6S9K68J"},
+	}
+	for _, tt := range tests {
+		if s := gen.CrockfordCheck(tt.data); s != tt.want {
+			t.Errorf("CrockfordCheck(%x) = %q, want %q", tt.data, s, tt.want)
+		}
+		got, err := gen.DecodeCrockfordCheck(tt.want)
+		if err != nil || !bytes.Equal(got, tt.data) {
+			t.Errorf("DecodeCrockfordCheck(%q) = %x, %v", tt.want, got, err)
+		}
+	}
+
+	s := gen.CrockfordCheck(data)
+	got, err = gen.DecodeCrockfordCheck(strings.ToLower(s))
+	if err != nil || !bytes.Equal(got, data) {
+		t.Fatalf("DecodeCrockfordCheck = %q, %v", got, err)
+	}
+	bad := s[:len(s)-1] + "0"
+	if s[len(s)-1] == '0' {
+		bad = s[:len(s)-1] + "1"
+	}
+	if _, err := gen.DecodeCrockfordCheck(bad); !errors.Is(err, gen.ErrInvalidEncoding) {
+		t.Fatalf("wrong check symbol accepted: %v", err)
+	}
+}
+
+func TestBase58(t *testing.T) {
+	// Vectors from Bitcoin Core base58_encode_decode.json.
+	tests := []struct{ hex, enc string }{
+		{"", ""},
+		{"61", "2g"},
+		{"626262", "a3gV"},
+		{"636363", "aPEr"},
+		{"73696d706c792061206c6f6e6720737472696e67", "2cFupjhnEsSn59qHXstmK2ffpLv2"},
+		{"00eb15231dfceb60925886b67d065299925915aeb172c06647", "1NS17iag9jJgTHD1VXjvLCEnZuQ3rJDE9L"},
+		{"516b6fcd0f", "ABnLTmg"},
+		{"bf4f89001e670274dd", "3SEo3LWLoPntC"},
+		{"572e4794", "3EFU7m"},
+		{"ecac89cad93923c02321", "EJDM8drfXA6uyA"},
+		{"10c8511e", "Rt5zm"},
+		{"00000000000000000000", "1111111111"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.enc, func(t *testing.T) {
+			data := mustHex(t, tt.hex)
+			if got := gen.Base58(data); got != tt.enc {
+				t.Fatalf("Base58 = %q, want %q", got, tt.enc)
+			}
+			got, err := gen.DecodeBase58(tt.enc)
+			if err != nil || !bytes.Equal(got, data) {
+				t.Fatalf("DecodeBase58 = %x, %v", got, err)
+			}
+		})
+	}
+	if _, err := gen.DecodeBase58("0OIl"); !errors.Is(err, gen.ErrInvalidEncoding) {
+		t.Fatalf("want ErrInvalidEncoding, got %v", err)
+	}
+}
+
+func TestBase58Check(t *testing.T) {
+	payload := mustHex(t, "010966776006953d5567439e5e39f86a0d273bee")
+	const addr = "16UwLL9Risc3QfPqBUvKofHmBQ7wMtjvM"
+	if got := gen.Base58Check(0, payload); got != addr {
+		t.Fatalf("Base58Check = %q, want %q", got, addr)
+	}
+	v, got, err := gen.DecodeBase58Check(addr)
+	if err != nil || v != 0 || !bytes.Equal(got, payload) {
+		t.Fatalf("DecodeBase58Check = %d, %x, %v", v, got, err)
+	}
+	for _, bad := range []string{"16UwLL9Risc3QfPqBUvKofHmBQ7wMtjvN", "1111"} {
+		if _, _, err := gen.DecodeBase58Check(bad); !errors.Is(err, gen.ErrInvalidEncoding) {
+			t.Errorf("DecodeBase58Check(%q) want ErrInvalidEncoding, got %v", bad, err)
+		}
+	}
+}
+
+func TestBase64URL(t *testing.T) {
+	data := []byte{0xfb, 0xff, 0xbf, 0x01}
+	if got := gen.Base64URL(data); got != "-_-_AQ" {
+		t.Fatalf("Base64URL = %q", got)
+	}
+	for _, in := range []string{"-_-_AQ", "-_-_AQ==", " -_-_\nAQ "} {
+		got, err := gen.DecodeBase64URL(in)
+		if err != nil || !bytes.Equal(got, data) {
+			t.Errorf("DecodeBase64URL(%q) = %x, %v", in, got, err)
+		}
+	}
+	if _, err := gen.DecodeBase64URL("+/+/AQ"); !errors.Is(err, gen.ErrInvalidEncoding) {
+		t.Fatalf("standard alphabet accepted: %v", err)
+	}
+}
+
+func TestZ85(t *testing.T) {
+	// Example from the ZeroMQ Z85 specification.
+	data := []byte{0x86, 0x4F, 0xD2, 0x6F, 0xB5, 0x59, 0xF7, 0x5B}
+	got, err := gen.Z85(data)
+	if err != nil || got != "HelloWorld" {
+		t.Fatalf("Z85 = %q, %v", got, err)
+	}
+	dec, err := gen.DecodeZ85("HelloWorld")
+	if err != nil || !bytes.Equal(dec, data) {
+		t.Fatalf("DecodeZ85 = %x, %v", dec, err)
+	}
+	if _, err := gen.Z85([]byte{1, 2, 3}); !errors.Is(err, gen.ErrInvalidEncoding) {
+		t.Errorf("Z85 of 3 bytes: %v", err)
+	}
+	for _, bad := range []string{"Hell~", "#####", "HelloWorl"} {
+		if _, err := gen.DecodeZ85(bad); !errors.Is(err, gen.ErrInvalidEncoding) {
+			t.Errorf("DecodeZ85(%q) want ErrInvalidEncoding, got %v", bad, err)
+		}
+	}
+}
+
+func TestBech32(t *testing.T) {
+	// Valid strings from BIP-173 and BIP-350.
+	valid := []struct {
+		s       string
+		hrp     string
+		variant gen.Bech32Variant
+	}{
+		{"A12UEL5L", "a", gen.Bech32},
+		{"a12uel5l", "a", gen.Bech32},
+		{"abcdef1qpzry9x8gf2tvdw0s3jn54khce6mua7lmqqqxw", "abcdef", gen.Bech32},
+		{"A1LQFN3A", "a", gen.Bech32m},
+		{"abcdef1l7aum6echk45nj3s0wdvt2fg8x9yrzpqzd3ryx", "abcdef", gen.Bech32m},
+	}
+	for _, tt := range valid {
+		t.Run(tt.s, func(t *testing.T) {
+			hrp, data, v, err := gen.DecodeBech32(tt.s)
+			if err != nil || hrp != tt.hrp || v != tt.variant {
+				t.Fatalf("DecodeBech32 = %q, %x, %v, %v", hrp, data, v, err)
+			}
+			enc, err := gen.EncodeBech32(hrp, data, v)
+			if err != nil || enc != strings.ToLower(tt.s) {
+				t.Fatalf("EncodeBech32 = %q, %v", enc, err)
+			}
+		})
+	}
+
+	invalid := []string{
+		"A1G7SGD8",     // checksum over upper case hrp
+		"a1Lqfn3a",     // mixed case
+		"1qzzfhee",     // empty hrp
+		"pzry9x0s0muk", // no separator
+		"x1b4n0q5v",    // bad data character
+		"a12uel5m",     // wrong checksum
+		"an84characterslonghumanreadablepartthatcontainsthetheexcludedcharactersbioandnumber11d6pts4",
+	}
+	for _, s := range invalid {
+		if _, _, _, err := gen.DecodeBech32(s); !errors.Is(err, gen.ErrInvalidEncoding) {
+			t.Errorf("DecodeBech32(%q) want ErrInvalidEncoding, got %v", s, err)
+		}
+	}
+
+	key := bytes.Repeat([]byte{0xa5}, 32)
+	s, err := gen.EncodeBech32("bsg", key, gen.Bech32m)
+	if err != nil || !strings.HasPrefix(s, "bsg1") {
+		t.Fatalf("EncodeBech32 = %q, %v", s, err)
+	}
+	_, got, _, err := gen.DecodeBech32(strings.ToUpper(s))
+	if err != nil || !bytes.Equal(got, key) {
+		t.Fatalf("round trip = %x, %v", got, err)
+	}
+	if _, err := gen.EncodeBech32("bsg", make([]byte, 60), gen.Bech32); !errors.Is(err, gen.ErrInvalidEncoding) {
+		t.Fatalf("over long string accepted: %v", err)
+	}
+}
+
+func TestToken(t *testing.T) {
+	check := map[gen.Encoding]func(string) ([]byte, error){
+		gen.EncodingHex:       hex.DecodeString,
+		gen.EncodingBase32:    gen.DecodeBase32,
+		gen.EncodingCrockford: gen.DecodeCrockford,
+		gen.EncodingBase58:    gen.DecodeBase58,
+		gen.EncodingBase64URL: gen.DecodeBase64URL,
+		gen.EncodingZ85:       gen.DecodeZ85,
+	}
+	for enc, decode := range check {
+		s, err := gen.Token(16, enc)
+		if err != nil {
+			t.Fatalf("Token(16, %d): %v", enc, err)
+		}
+		b, err := decode(s)
+		if err != nil || len(b) != 16 {
+			t.Errorf("Token(16, %d) = %q decodes to %d bytes, %v", enc, s, len(b), err)
+		}
+	}
+	if _, err := gen.Token(6, gen.EncodingZ85); !errors.Is(err, gen.ErrInvalidArgument) {
+		t.Errorf("Z85 with 6 bytes: %v", err)
+	}
+	if _, err := gen.Token(0, gen.EncodingHex); !errors.Is(err, gen.ErrInvalidArgument) {
+		t.Errorf("zero length: %v", err)
+	}
+
+	g := gen.NewSeededGenerator([32]byte{7})
+	h := gen.NewSeededGenerator([32]byte{7})
+	a, _ := gen.TokenFrom(g, 20, gen.EncodingBase58)
+	b, _ := gen.TokenFrom(h, 20, gen.EncodingBase58)
+	if a != b {
+		t.Errorf("seeded tokens differ: %q != %q", a, b)
+	}
+
+	s, err := gen.TokenBech32("tok", 32)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, data, v, err := gen.DecodeBech32(s); err != nil || len(data) != 32 || v != gen.Bech32m {
+		t.Errorf("TokenBech32 = %q: %d bytes, %v, %v", s, len(data), v, err)
+	}
+}
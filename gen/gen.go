@@ -50,33 +50,14 @@
 // various application to sure transactions and IDs.
 package gen
 
-import (
-	"crypto/rand"
-	"encoding/binary"
-	"math/big"
-)
-
 // Uint64 generates a secure random uint64 value.
 func Uint64() uint64 {
-	var b [8]byte
-	_, err := rand.Read(b[:])
-	if err != nil {
-		panic("crypto/rand failed: " + err.Error())
-	}
-	return binary.LittleEndian.Uint64(b[:])
+	return must(defaultGenerator.Uint64())
 }
 
-// Uint64N returns a secure random uint64 in [0, n].
+// Uint64N returns a secure random uint64 in [0, n).
 func Uint64N(n uint64) uint64 {
-	if n == 0 {
-		panic("Uint64N: argument must be > 0")
-	}
-	max := new(big.Int).SetUint64(n)
-	r, err := rand.Int(rand.Reader, max)
-	if err != nil {
-		panic("crypto/rand failed: " + err.Error())
-	}
-	return r.Uint64()
+	return must(defaultGenerator.Uint64N(n))
 }
 
 // Uint32 returns a secure random uint32.
@@ -84,7 +65,7 @@ func Uint32() uint32 {
 	return uint32(Uint64())
 }
 
-// Uint32N returns a secure random uint32 in [0, n].
+// Uint32N returns a secure random uint32 in [0, n).
 func Uint32N(n uint32) uint32 {
 	return uint32(Uint64N(uint64(n)))
 }
@@ -94,7 +75,7 @@ func Uint() uint {
 	return uint(Uint64())
 }
 
-// UintN returns a secure random uint in [0, n].
+// UintN returns a secure random uint in [0, n).
 func UintN(n uint) uint {
 	return uint(Uint64N(uint64(n)))
 }
@@ -104,9 +85,9 @@ func Int64() int64 {
 	return int64(Uint64())
 }
 
-// Int64N returns a secure random int64 in [0, n].
+// Int64N returns a secure random int64 in [0, n). It panics if n <= 0.
 func Int64N(n int64) int64 {
-	return int64(Uint64N(uint64(n)))
+	return must(defaultGenerator.Int64N(n))
 }
 
 // Int32 returns a secure random int32.
@@ -114,9 +95,9 @@ func Int32() int32 {
 	return int32(Uint64())
 }
 
-// Int32N returns a secure random int32 in [0, n].
+// Int32N returns a secure random int32 in [0, n). It panics if n <= 0.
 func Int32N(n int32) int32 {
-	return int32(Uint64N(uint64(n)))
+	return must(defaultGenerator.Int32N(n))
 }
 
 // Int returns a secure random int.
@@ -124,41 +105,27 @@ func Int() int {
 	return int(Uint64())
 }
 
-// IntN returns a secure random int in [0, n].
+// IntN returns a secure random int in [0, n). It panics if n <= 0.
 func IntN(n int) int {
-	return int(Uint64N(uint64(n)))
+	return must(defaultGenerator.IntN(n))
 }
 
-// Float64 returns a secure random float64 in [0.0, 1.0].
+// Float64 returns a secure random float64 in [0.0, 1.0).
 func Float64() float64 {
-	const bits = 53
-	r := Uint64() >> (64 - bits)
-	return float64(r) / (1 << bits)
+	return must(defaultGenerator.Float64())
 }
 
-// Float32 returns a secure random float32 in [0.0, 1.0].
+// Float32 returns a secure random float32 in [0.0, 1.0).
 func Float32() float32 {
-	const bits = 24
-	r := Uint64() >> (64 - bits)
-	return float32(r) / float32(1<<bits)
+	return must(defaultGenerator.Float32())
 }
 
 // Shuffle shuffles the indices [0, n) using the provided swap function.
 func Shuffle(n int, swap func(i, j int)) {
-	for i := n - 1; i > 0; i-- {
-		j := IntN(i + 1)
-		swap(i, j)
-	}
+	must(0, defaultGenerator.Shuffle(n, swap))
 }
 
 // Perm returns a random permutation of the integers [0, n).
 func Perm(n int) []int {
-	m := make([]int, n)
-	for i := 0; i < n; i++ {
-		m[i] = i
-	}
-	Shuffle(n, func(i, j int) {
-		m[i], m[j] = m[j], m[i]
-	})
-	return m
+	return must(defaultGenerator.Perm(n))
 }
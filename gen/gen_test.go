@@ -54,6 +54,18 @@ import (
 	"github.com/boseji/bsg/gen"
 )
 
+// chiSquare returns the chi-square statistic of the observed counts
+// against a uniform distribution.
+func chiSquare(counts []int, total int) float64 {
+	expected := float64(total) / float64(len(counts))
+	var x float64
+	for _, c := range counts {
+		d := float64(c) - expected
+		x += d * d / expected
+	}
+	return x
+}
+
 func TestIntN(t *testing.T) {
 	for i := 0; i < 100; i++ {
 		v := gen.IntN(100)
@@ -138,3 +150,138 @@ func TestIntNZeroPanics(t *testing.T) {
 	_ = gen.IntN(0)
 	t.Errorf("Expected panic for gen.IntN(0)")
 }
+
+// TestUniformSmall checks IntN for small bounds with a chi-square test at
+// the 0.1% level. The seeded generator keeps the test reproducible.
+func TestUniformSmall(t *testing.T) {
+	// Critical values of chi-square at p = 0.001 by degrees of freedom
+	critical := map[int]float64{2: 13.816, 6: 22.458, 9: 27.877, 36: 67.985}
+	g := gen.NewSeededGenerator([32]byte{42})
+	const draws = 200000
+
+	for _, n := range []int{3, 7, 10, 37} {
+		counts := make([]int, n)
+		for i := 0; i < draws; i++ {
+			v, err := g.IntN(n)
+			if err != nil {
+				t.Fatal(err)
+			}
+			counts[v]++
+		}
+		if x := chiSquare(counts, draws); x > critical[n-1] {
+			t.Errorf("IntN(%d) not uniform: chi-square %.2f > %.2f", n, x, critical[n-1])
+		}
+	}
+}
+
+// TestUniformLarge checks for modulo bias with a bound close to 2^64,
+// where a plain v % n would make the lowest third twice as likely.
+func TestUniformLarge(t *testing.T) {
+	g := gen.NewSeededGenerator([32]byte{7})
+	const n = uint64(3) << 62
+	const draws = 30000
+	counts := make([]int, 3)
+	for i := 0; i < draws; i++ {
+		v, err := g.Uint64N(n)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if v >= n {
+			t.Fatalf("Uint64N(%d) out of range: %d", n, v)
+		}
+		counts[v/(n/3)]++
+	}
+	if x := chiSquare(counts, draws); x > 13.816 {
+		t.Errorf("Uint64N biased: chi-square %.2f, counts %v", x, counts)
+	}
+}
+
+// TestPermUniform checks that all 24 permutations of 4 items are equally
+// likely.
+func TestPermUniform(t *testing.T) {
+	g := gen.NewSeededGenerator([32]byte{9})
+	const draws = 48000
+	counts := map[[4]int]int{}
+	for i := 0; i < draws; i++ {
+		p, err := g.Perm(4)
+		if err != nil {
+			t.Fatal(err)
+		}
+		counts[[4]int(p)]++
+	}
+	if len(counts) != 24 {
+		t.Fatalf("expected 24 permutations, got %d", len(counts))
+	}
+	list := make([]int, 0, 24)
+	for _, c := range counts {
+		list = append(list, c)
+	}
+	// 23 degrees of freedom at p = 0.001
+	if x := chiSquare(list, draws); x > 49.728 {
+		t.Errorf("Perm not uniform: chi-square %.2f", x)
+	}
+}
+
+func TestHotPathAllocations(t *testing.T) {
+	g := gen.NewSeededGenerator([32]byte{})
+	tests := []struct {
+		name string
+		fn   func()
+	}{
+		{"Uint64N", func() { g.Uint64N(1000) }},
+		{"IntN", func() { g.IntN(1000) }},
+		{"Float64", func() { g.Float64() }},
+		{"Shuffle", func() { g.Shuffle(16, func(i, j int) {}) }},
+		{"package IntN", func() { gen.IntN(1000) }},
+	}
+	for _, tt := range tests {
+		if allocs := testing.AllocsPerRun(100, tt.fn); allocs != 0 {
+			t.Errorf("%s allocates %.1f times per call", tt.name, allocs)
+		}
+	}
+
+	// Only the result itself should be allocated
+	if allocs := testing.AllocsPerRun(100, func() { g.String(gen.CharSet, 32) }); allocs > 1 {
+		t.Errorf("String allocates %.1f times per call", allocs)
+	}
+}
+
+func BenchmarkUint64N(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		gen.Uint64N(1000)
+	}
+}
+
+func BenchmarkIntN(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		gen.IntN(62)
+	}
+}
+
+func BenchmarkShuffle(b *testing.B) {
+	data := make([]int, 64)
+	for i := 0; i < b.N; i++ {
+		gen.Shuffle(len(data), func(i, j int) {
+			data[i], data[j] = data[j], data[i]
+		})
+	}
+}
+
+func BenchmarkPerm(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		gen.Perm(64)
+	}
+}
+
+func BenchmarkString(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		gen.String(gen.CharSet, 32)
+	}
+}
+
+func BenchmarkStringSeeded(b *testing.B) {
+	g := gen.NewSeededGenerator([32]byte{})
+	for i := 0; i < b.N; i++ {
+		g.String(gen.CharSet, 32)
+	}
+}
@@ -0,0 +1,381 @@
+// generator.go - Part of the `gen` Package
+//
+//     ॐ भूर्भुवः स्वः
+//     तत्स॑वि॒तुर्वरे॑ण्यं॒
+//    भर्गो॑ दे॒वस्य॑ धीमहि।
+//   धियो॒ यो नः॑ प्रचो॒दया॑त्॥
+//
+//
+//  बी.स.जी - बोसजी के द्वारा रचित सुरक्षा एवं गोपनीयता हेतु तन्त्राक्ष्।
+// ================================================
+//
+// एक सुरक्षा एवं गोपनीयता केंद्रित तंत्राक्षों का संकलन।
+//
+// एक रचनात्मक भारतीय उत्पाद ।
+//
+// bsg - Boseji's Security and Privacy Utilities
+//
+// A collection of Security and Privacy utilities and some notes for help.
+//
+// This is **Golang** package collection as well as few utility
+// command line programs.
+//
+// Sources
+// -------
+// https://github.com/boseji/bsg
+//
+// License
+// -------
+//
+//   bsg - Boseji's Security and Privacy Utilities.
+//   Copyright (C) 2025-2026 by Abhijit Bose (aka. Boseji)
+//
+//   This program is free software: you can redistribute it and/or modify
+//   it under the terms of the GNU General Public License version 2 only
+//   as published by the Free Software Foundation.
+//
+//   This program is distributed in the hope that it will be useful,
+//   but WITHOUT ANY WARRANTY; without even the implied warranty of
+//   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+//
+//   You should have received a copy of the GNU General Public License
+//   along with this program. If not, see <https://www.gnu.org/licenses/>.
+//
+//  SPDX-License-Identifier: GPL-2.0-only
+//  Full Name: GNU General Public License v2.0 only
+//  Please visit <https://spdx.org/licenses/GPL-2.0-only.html> for details.
+//
+
+package gen
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math/bits"
+	mrand "math/rand/v2"
+	"sync"
+	"unicode/utf8"
+)
+
+var ErrInvalidArgument = errors.New("invalid argument")
+
+// generatorBuffer is the most random bytes read from the source at a
+// time. Only the bytes needed must be available, so short deterministic
+// readers work too. Consumed bytes are wiped from the buffer.
+const generatorBuffer = 256
+
+// Generator produces random values from any io.Reader. It is safe for
+// concurrent use. Unlike the package level functions its methods return
+// errors instead of panicking.
+type Generator struct {
+	mu  sync.Mutex
+	r   io.Reader
+	buf [generatorBuffer]byte
+	off int // Next unread byte in buf.
+	end int // End of the unread bytes, equal to off when empty.
+}
+
+// defaultGenerator backs the package level functions.
+var defaultGenerator = NewGenerator(rand.Reader)
+
+// NewGenerator creates a Generator reading from r.
+// Use crypto/rand.Reader for secure values.
+func NewGenerator(r io.Reader) *Generator {
+	return &Generator{r: r}
+}
+
+// NewSeededGenerator creates a deterministic Generator from a ChaCha8
+// DRBG with the given seed. Meant for reproducible tests only, the
+// output is predictable to anyone who knows the seed.
+func NewSeededGenerator(seed [32]byte) *Generator {
+	return NewGenerator(mrand.NewChaCha8(seed))
+}
+
+// Default returns the Generator used by the package level functions,
+// which reads from crypto/rand.
+func Default() *Generator {
+	return defaultGenerator
+}
+
+// Read fills p entirely with random bytes.
+func (g *Generator) Read(p []byte) (int, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if err := g.read(p); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// Uint64 returns a random uint64.
+func (g *Generator) Uint64() (uint64, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.uint64()
+}
+
+// Uint64N returns a random uint64 in [0, n).
+func (g *Generator) Uint64N(n uint64) (uint64, error) {
+	if n == 0 {
+		return 0, fmt.Errorf("Uint64N: %w: must be > 0", ErrInvalidArgument)
+	}
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.uint64n(n)
+}
+
+// Uint32 returns a random uint32.
+func (g *Generator) Uint32() (uint32, error) {
+	v, err := g.Uint64()
+	return uint32(v), err
+}
+
+// Uint32N returns a random uint32 in [0, n).
+func (g *Generator) Uint32N(n uint32) (uint32, error) {
+	v, err := g.Uint64N(uint64(n))
+	return uint32(v), err
+}
+
+// Uint returns a random uint.
+func (g *Generator) Uint() (uint, error) {
+	v, err := g.Uint64()
+	return uint(v), err
+}
+
+// UintN returns a random uint in [0, n).
+func (g *Generator) UintN(n uint) (uint, error) {
+	v, err := g.Uint64N(uint64(n))
+	return uint(v), err
+}
+
+// Int64 returns a random int64.
+func (g *Generator) Int64() (int64, error) {
+	v, err := g.Uint64()
+	return int64(v), err
+}
+
+// Int64N returns a random int64 in [0, n). It fails for n <= 0.
+func (g *Generator) Int64N(n int64) (int64, error) {
+	if n <= 0 {
+		return 0, fmt.Errorf("Int64N: %w: must be > 0", ErrInvalidArgument)
+	}
+	v, err := g.Uint64N(uint64(n))
+	return int64(v), err
+}
+
+// Int32 returns a random int32.
+func (g *Generator) Int32() (int32, error) {
+	v, err := g.Uint64()
+	return int32(v), err
+}
+
+// Int32N returns a random int32 in [0, n). It fails for n <= 0.
+func (g *Generator) Int32N(n int32) (int32, error) {
+	if n <= 0 {
+		return 0, fmt.Errorf("Int32N: %w: must be > 0", ErrInvalidArgument)
+	}
+	v, err := g.Uint64N(uint64(n))
+	return int32(v), err
+}
+
+// Int returns a random int.
+func (g *Generator) Int() (int, error) {
+	v, err := g.Uint64()
+	return int(v), err
+}
+
+// IntN returns a random int in [0, n). It fails for n <= 0.
+func (g *Generator) IntN(n int) (int, error) {
+	if n <= 0 {
+		return 0, fmt.Errorf("IntN: %w: must be > 0", ErrInvalidArgument)
+	}
+	v, err := g.Uint64N(uint64(n))
+	return int(v), err
+}
+
+// Float64 returns a random float64 in [0.0, 1.0).
+func (g *Generator) Float64() (float64, error) {
+	const bits = 53
+	v, err := g.Uint64()
+	return float64(v>>(64-bits)) / (1 << bits), err
+}
+
+// Float32 returns a random float32 in [0.0, 1.0).
+func (g *Generator) Float32() (float32, error) {
+	const bits = 24
+	v, err := g.Uint64()
+	return float32(v>>(64-bits)) / float32(1<<bits), err
+}
+
+// Shuffle shuffles the indices [0, n) using the provided swap function.
+func (g *Generator) Shuffle(n int, swap func(i, j int)) error {
+	if n < 0 {
+		return fmt.Errorf("Shuffle: %w: n must be >= 0", ErrInvalidArgument)
+	}
+	for i := n - 1; i > 0; i-- {
+		j, err := g.IntN(i + 1)
+		if err != nil {
+			return err
+		}
+		swap(i, j)
+	}
+	return nil
+}
+
+// Perm returns a random permutation of the integers [0, n).
+func (g *Generator) Perm(n int) ([]int, error) {
+	if n < 0 {
+		return nil, fmt.Errorf("Perm: %w: n must be >= 0", ErrInvalidArgument)
+	}
+	m := make([]int, n)
+	for i := 0; i < n; i++ {
+		m[i] = i
+	}
+	err := g.Shuffle(n, func(i, j int) {
+		m[i], m[j] = m[j], m[i]
+	})
+	if err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// String generates a random string.
+// charset: the set of characters to select from.
+// length: the desired length of the output string.
+func (g *Generator) String(charset string, length int) (string, error) {
+	if length <= 0 {
+		return "", fmt.Errorf("length must be positive")
+	}
+	if len(charset) == 0 {
+		return "", fmt.Errorf("charset must not be empty")
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	// ASCII only charsets can be indexed by byte.
+	if isASCII(charset) {
+		result := make([]byte, length)
+		for i := range result {
+			n, err := g.uint64n(uint64(len(charset)))
+			if err != nil {
+				return "", fmt.Errorf("failed to generate random index: %w", err)
+			}
+			result[i] = charset[n]
+		}
+		return string(result), nil
+	}
+
+	runes := []rune(charset)
+	result := make([]rune, length)
+	for i := range result {
+		n, err := g.uint64n(uint64(len(runes)))
+		if err != nil {
+			return "", fmt.Errorf("failed to generate random index: %w", err)
+		}
+		result[i] = runes[n]
+	}
+	return string(result), nil
+}
+
+// read fills p from the buffer, refilling it from the source as needed.
+// Large reads skip the buffer. The caller must hold g.mu.
+func (g *Generator) read(p []byte) error {
+	for len(p) > 0 {
+		if g.off == g.end {
+			if len(p) >= len(g.buf) {
+				if _, err := io.ReadFull(g.r, p); err != nil {
+					return fmt.Errorf("random source failed: %w", err)
+				}
+				return nil
+			}
+			if err := g.fill(len(p)); err != nil {
+				return err
+			}
+		}
+		n := copy(p, g.buf[g.off:g.end])
+		clear(g.buf[g.off : g.off+n])
+		g.off += n
+		p = p[n:]
+	}
+	return nil
+}
+
+// fill discards what is left in the buffer and reads it afresh from the
+// source, at least need bytes and as many as fit. The caller must hold
+// g.mu.
+func (g *Generator) fill(need int) error {
+	clear(g.buf[g.off:g.end])
+	g.off, g.end = 0, 0
+	n, err := io.ReadAtLeast(g.r, g.buf[:], need)
+	if err != nil {
+		clear(g.buf[:n])
+		return fmt.Errorf("random source failed: %w", err)
+	}
+	g.end = n
+	return nil
+}
+
+// uint64 takes the next 8 random bytes. The caller must hold g.mu.
+func (g *Generator) uint64() (uint64, error) {
+	if g.end-g.off < 8 {
+		if err := g.fill(8); err != nil {
+			return 0, err
+		}
+	}
+	b := g.buf[g.off : g.off+8]
+	v := binary.LittleEndian.Uint64(b)
+	clear(b)
+	g.off += 8
+	return v, nil
+}
+
+// uint64n returns a uniform value in [0, n) for n > 0 using Lemire's
+// multiply and reject method, which avoids both math/big and most
+// divisions. The caller must hold g.mu.
+//
+// See https://arxiv.org/abs/1805.10941
+func (g *Generator) uint64n(n uint64) (uint64, error) {
+	if n&(n-1) == 0 {
+		v, err := g.uint64()
+		return v & (n - 1), err
+	}
+	v, err := g.uint64()
+	if err != nil {
+		return 0, err
+	}
+	hi, lo := bits.Mul64(v, n)
+	if lo < n {
+		thresh := -n % n
+		for lo < thresh {
+			if v, err = g.uint64(); err != nil {
+				return 0, err
+			}
+			hi, lo = bits.Mul64(v, n)
+		}
+	}
+	return hi, nil
+}
+
+// isASCII reports if every byte of s is a single byte character.
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
+
+// must unwraps a Generator result for the package level functions,
+// which keep their original panic on failure.
+func must[T any](v T, err error) T {
+	if err != nil {
+		panic(err.Error())
+	}
+	return v
+}
@@ -0,0 +1,191 @@
+// generator_test.go - Test Program `gen` Package
+//
+//     ॐ भूर्भुवः स्वः
+//     तत्स॑वि॒तुर्वरे॑ण्यं॒
+//    भर्गो॑ दे॒वस्य॑ धीमहि।
+//   धियो॒ यो नः॑ प्रचो॒दया॑त्॥
+//
+//
+//  बी.स.जी - बोसजी के द्वारा रचित सुरक्षा एवं गोपनीयता हेतु तन्त्राक्ष्।
+// ================================================
+//
+// एक सुरक्षा एवं गोपनीयता केंद्रित तंत्राक्षों का संकलन।
+//
+// एक रचनात्मक भारतीय उत्पाद ।
+//
+// bsg - Boseji's Security and Privacy Utilities
+//
+// A collection of Security and Privacy utilities and some notes for help.
+//
+// This is **Golang** package collection as well as few utility
+// command line programs.
+//
+// Sources
+// -------
+// https://github.com/boseji/bsg
+//
+// License
+// -------
+//
+//   bsg - Boseji's Security and Privacy Utilities.
+//   Copyright (C) 2025-2026 by Abhijit Bose (aka. Boseji)
+//
+//   This program is free software: you can redistribute it and/or modify
+//   it under the terms of the GNU General Public License version 2 only
+//   as published by the Free Software Foundation.
+//
+//   This program is distributed in the hope that it will be useful,
+//   but WITHOUT ANY WARRANTY; without even the implied warranty of
+//   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+//
+//   You should have received a copy of the GNU General Public License
+//   along with this program. If not, see <https://www.gnu.org/licenses/>.
+//
+//  SPDX-License-Identifier: GPL-2.0-only
+//  Full Name: GNU General Public License v2.0 only
+//  Please visit <https://spdx.org/licenses/GPL-2.0-only.html> for details.
+//
+
+package gen_test
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+	"testing/iotest"
+
+	"github.com/boseji/bsg/gen"
+)
+
+// failingReader always returns its error.
+type failingReader struct{ err error }
+
+func (f failingReader) Read(p []byte) (int, error) { return 0, f.err }
+
+// sample draws one of everything from the generator.
+func sample(t *testing.T, g *gen.Generator) []any {
+	var out []any
+	add := func(v any, err error) {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		out = append(out, v)
+	}
+	add(g.Uint64())
+	add(g.Uint64N(1000))
+	add(g.Uint32())
+	add(g.Uint32N(1000))
+	add(g.Uint())
+	add(g.UintN(1000))
+	add(g.Int64())
+	add(g.Int64N(1000))
+	add(g.Int32())
+	add(g.Int32N(1000))
+	add(g.Int())
+	add(g.IntN(1000))
+	add(g.Float64())
+	add(g.Float32())
+	add(g.Perm(20))
+	add(g.String(gen.CharSet, 32))
+
+	data := []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}
+	err := g.Shuffle(len(data), func(i, j int) {
+		data[i], data[j] = data[j], data[i]
+	})
+	add(data, err)
+
+	buf := make([]byte, 16)
+	_, err = g.Read(buf)
+	add(buf, err)
+	return out
+}
+
+func TestSeededGeneratorDeterministic(t *testing.T) {
+	seed := [32]byte{1, 2, 3}
+	a := sample(t, gen.NewSeededGenerator(seed))
+	b := sample(t, gen.NewSeededGenerator(seed))
+	if !reflect.DeepEqual(a, b) {
+		t.Errorf("same seed gave different values:\n%v\n%v", a, b)
+	}
+
+	c := sample(t, gen.NewSeededGenerator([32]byte{4, 5, 6}))
+	if reflect.DeepEqual(a, c) {
+		t.Error("different seeds gave the same values")
+	}
+}
+
+func TestGeneratorReader(t *testing.T) {
+	// A fixed byte stream gives fixed values
+	stream := bytes.Repeat([]byte{0x01, 0, 0, 0, 0, 0, 0, 0}, 4)
+	g := gen.NewGenerator(bytes.NewReader(stream))
+	v, err := g.Uint64()
+	if err != nil || v != 1 {
+		t.Errorf("Uint64 = %d, %v; expected 1", v, err)
+	}
+	f, err := g.Float64()
+	if err != nil || f != 0 {
+		t.Errorf("Float64 = %f, %v; expected 0", f, err)
+	}
+}
+
+func TestGeneratorShortReader(t *testing.T) {
+	// Only the bytes needed are read, even from a trickling source
+	stream := bytes.Repeat([]byte{0x02, 0, 0, 0, 0, 0, 0, 0}, 2)
+	g := gen.NewGenerator(iotest.OneByteReader(bytes.NewReader(stream)))
+	for i := range 2 {
+		if v, err := g.Uint64(); err != nil || v != 2 {
+			t.Fatalf("Uint64 #%d = %d, %v; expected 2", i, v, err)
+		}
+	}
+	if _, err := g.Uint64(); err == nil {
+		t.Error("Uint64 past the end of the stream should fail")
+	}
+}
+
+func TestGeneratorErrors(t *testing.T) {
+	sourceErr := errors.New("source broken")
+	g := gen.NewGenerator(failingReader{sourceErr})
+
+	if _, err := g.Uint64(); !errors.Is(err, sourceErr) {
+		t.Errorf("Uint64 error = %v, expected %v", err, sourceErr)
+	}
+	if _, err := g.IntN(10); !errors.Is(err, sourceErr) {
+		t.Errorf("IntN error = %v, expected %v", err, sourceErr)
+	}
+	if _, err := g.Float64(); !errors.Is(err, sourceErr) {
+		t.Errorf("Float64 error = %v, expected %v", err, sourceErr)
+	}
+	if _, err := g.Perm(5); !errors.Is(err, sourceErr) {
+		t.Errorf("Perm error = %v, expected %v", err, sourceErr)
+	}
+	if _, err := g.String("abc", 5); !errors.Is(err, sourceErr) {
+		t.Errorf("String error = %v, expected %v", err, sourceErr)
+	}
+	if err := g.Shuffle(5, func(i, j int) {}); !errors.Is(err, sourceErr) {
+		t.Errorf("Shuffle error = %v, expected %v", err, sourceErr)
+	}
+}
+
+func TestGeneratorInvalidArgument(t *testing.T) {
+	g := gen.NewSeededGenerator([32]byte{})
+	if _, err := g.Uint64N(0); !errors.Is(err, gen.ErrInvalidArgument) {
+		t.Errorf("Uint64N(0) error = %v, expected ErrInvalidArgument", err)
+	}
+	if _, err := g.IntN(0); !errors.Is(err, gen.ErrInvalidArgument) {
+		t.Errorf("IntN(0) error = %v, expected ErrInvalidArgument", err)
+	}
+	if _, err := g.Perm(-1); !errors.Is(err, gen.ErrInvalidArgument) {
+		t.Errorf("Perm(-1) error = %v, expected ErrInvalidArgument", err)
+	}
+}
+
+func TestDefaultGenerator(t *testing.T) {
+	if gen.Default() == nil || gen.Default() != gen.Default() {
+		t.Fatal("Default() must return the same generator")
+	}
+	v, err := gen.Default().IntN(100)
+	if err != nil || v < 0 || v >= 100 {
+		t.Errorf("Default().IntN(100) = %d, %v", v, err)
+	}
+}
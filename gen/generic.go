@@ -0,0 +1,251 @@
+// generic.go - Part of the `gen` Package
+//
+//     ॐ भूर्भुवः स्वः
+//     तत्स॑वि॒तुर्वरे॑ण्यं॒
+//    भर्गो॑ दे॒वस्य॑ धीमहि।
+//   धियो॒ यो नः॑ प्रचो॒दया॑त्॥
+//
+//
+//  बी.स.जी - बोसजी के द्वारा रचित सुरक्षा एवं गोपनीयता हेतु तन्त्राक्ष्।
+// ================================================
+//
+// एक सुरक्षा एवं गोपनीयता केंद्रित तंत्राक्षों का संकलन।
+//
+// एक रचनात्मक भारतीय उत्पाद ।
+//
+// bsg - Boseji's Security and Privacy Utilities
+//
+// A collection of Security and Privacy utilities and some notes for help.
+//
+// This is **Golang** package collection as well as few utility
+// command line programs.
+//
+// Sources
+// -------
+// https://github.com/boseji/bsg
+//
+// License
+// -------
+//
+//   bsg - Boseji's Security and Privacy Utilities.
+//   Copyright (C) 2025-2026 by Abhijit Bose (aka. Boseji)
+//
+//   This program is free software: you can redistribute it and/or modify
+//   it under the terms of the GNU General Public License version 2 only
+//   as published by the Free Software Foundation.
+//
+//   This program is distributed in the hope that it will be useful,
+//   but WITHOUT ANY WARRANTY; without even the implied warranty of
+//   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+//
+//   You should have received a copy of the GNU General Public License
+//   along with this program. If not, see <https://www.gnu.org/licenses/>.
+//
+//  SPDX-License-Identifier: GPL-2.0-only
+//  Full Name: GNU General Public License v2.0 only
+//  Please visit <https://spdx.org/licenses/GPL-2.0-only.html> for details.
+//
+
+package gen
+
+import (
+	"fmt"
+	"math"
+)
+
+// Integer is any integer type.
+type Integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// Float is any floating point type.
+type Float interface {
+	~float32 | ~float64
+}
+
+// Weight is any type usable as a weight in WeightedChoice.
+type Weight interface {
+	Integer | Float
+}
+
+// Range returns a secure random value in [min, max], both inclusive.
+func Range[T Integer](min, max T) (T, error) {
+	return RangeFrom(defaultGenerator, min, max)
+}
+
+// RangeFrom returns a random value in [min, max] from the generator.
+func RangeFrom[T Integer](g *Generator, min, max T) (T, error) {
+	if min > max {
+		return 0, fmt.Errorf("Range: %w: min %v > max %v", ErrInvalidArgument, min, max)
+	}
+	// Two's complement keeps the span right for signed types as well.
+	span := uint64(max) - uint64(min)
+	var v uint64
+	var err error
+	if span == math.MaxUint64 {
+		v, err = g.Uint64()
+	} else {
+		v, err = g.Uint64N(span + 1)
+	}
+	if err != nil {
+		return 0, err
+	}
+	return T(uint64(min) + v), nil
+}
+
+// Choice returns a secure random element of the slice.
+func Choice[T any](s []T) (T, error) {
+	return ChoiceFrom(defaultGenerator, s)
+}
+
+// ChoiceFrom returns a random element of the slice from the generator.
+func ChoiceFrom[T any](g *Generator, s []T) (T, error) {
+	var zero T
+	if len(s) == 0 {
+		return zero, fmt.Errorf("Choice: %w: empty slice", ErrInvalidArgument)
+	}
+	i, err := g.IntN(len(s))
+	if err != nil {
+		return zero, err
+	}
+	return s[i], nil
+}
+
+// Sample returns k distinct elements of the slice, chosen securely
+// without replacement. The input slice is left untouched.
+func Sample[T any](s []T, k int) ([]T, error) {
+	return SampleFrom(defaultGenerator, s, k)
+}
+
+// SampleFrom returns k elements of the slice without replacement from
+// the generator.
+func SampleFrom[T any](g *Generator, s []T, k int) ([]T, error) {
+	if k < 0 || k > len(s) {
+		return nil, fmt.Errorf("Sample: %w: k %d outside [0, %d]",
+			ErrInvalidArgument, k, len(s))
+	}
+	// Partial Fisher-Yates over a copy, only the first k are drawn.
+	c := append([]T(nil), s...)
+	for i := 0; i < k; i++ {
+		j, err := g.IntN(len(c) - i)
+		if err != nil {
+			return nil, err
+		}
+		c[i], c[i+j] = c[i+j], c[i]
+	}
+	return c[:k:k], nil
+}
+
+// WeightedChoice returns a secure random element of items where each is
+// picked with a probability proportional to its weight. Integer weights
+// are sampled exactly, float weights through Float64.
+func WeightedChoice[T any, W Weight](items []T, weights []W) (T, error) {
+	return WeightedChoiceFrom(defaultGenerator, items, weights)
+}
+
+// WeightedChoiceFrom returns a weighted random element of items from the
+// generator.
+func WeightedChoiceFrom[T any, W Weight](g *Generator, items []T,
+	weights []W) (T, error) {
+	var zero T
+	if len(items) == 0 || len(items) != len(weights) {
+		return zero, fmt.Errorf("WeightedChoice: %w: need one weight per item",
+			ErrInvalidArgument)
+	}
+
+	half := 0.5
+	if W(half) != 0 {
+		i, err := weightedFloat(g, weights)
+		if err != nil {
+			return zero, err
+		}
+		return items[i], nil
+	}
+	i, err := weightedInteger(g, weights)
+	if err != nil {
+		return zero, err
+	}
+	return items[i], nil
+}
+
+// ShuffleSlice securely shuffles the slice in place.
+func ShuffleSlice[T any](s []T) error {
+	return ShuffleSliceFrom(defaultGenerator, s)
+}
+
+// ShuffleSliceFrom shuffles the slice in place with the generator.
+func ShuffleSliceFrom[T any](g *Generator, s []T) error {
+	return g.Shuffle(len(s), func(i, j int) {
+		s[i], s[j] = s[j], s[i]
+	})
+}
+
+// weightedInteger picks an index with integer weights using an exact
+// draw over the total.
+func weightedInteger[W Weight](g *Generator, weights []W) (int, error) {
+	var total uint64
+	for _, w := range weights {
+		if w < 0 {
+			return 0, fmt.Errorf("WeightedChoice: %w: negative weight %v",
+				ErrInvalidArgument, w)
+		}
+		if total+uint64(w) < total {
+			return 0, fmt.Errorf("WeightedChoice: %w: weights overflow",
+				ErrInvalidArgument)
+		}
+		total += uint64(w)
+	}
+	if total == 0 {
+		return 0, fmt.Errorf("WeightedChoice: %w: weights sum to zero",
+			ErrInvalidArgument)
+	}
+
+	r, err := g.Uint64N(total)
+	if err != nil {
+		return 0, err
+	}
+	for i, w := range weights {
+		if r < uint64(w) {
+			return i, nil
+		}
+		r -= uint64(w)
+	}
+	return len(weights) - 1, nil // not reached
+}
+
+// weightedFloat picks an index with floating point weights.
+func weightedFloat[W Weight](g *Generator, weights []W) (int, error) {
+	var total float64
+	last := -1
+	for i, w := range weights {
+		f := float64(w)
+		if f < 0 || math.IsNaN(f) || math.IsInf(f, 0) {
+			return 0, fmt.Errorf("WeightedChoice: %w: bad weight %v",
+				ErrInvalidArgument, w)
+		}
+		if f > 0 {
+			last = i
+		}
+		total += f
+	}
+	if total == 0 || math.IsInf(total, 0) {
+		return 0, fmt.Errorf("WeightedChoice: %w: weights sum to %v",
+			ErrInvalidArgument, total)
+	}
+
+	u, err := g.Float64()
+	if err != nil {
+		return 0, err
+	}
+	r := u * total
+	for i, w := range weights {
+		if r < float64(w) {
+			return i, nil
+		}
+		r -= float64(w)
+	}
+	// Rounding can leave r just past the end, give it to the last
+	// item that can actually be chosen.
+	return last, nil
+}
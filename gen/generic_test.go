@@ -0,0 +1,274 @@
+// generic_test.go - Test Program `gen` Package
+//
+//     ॐ भूर्भुवः स्वः
+//     तत्स॑वि॒तुर्वरे॑ण्यं॒
+//    भर्गो॑ दे॒वस्य॑ धीमहि।
+//   धियो॒ यो नः॑ प्रचो॒दया॑त्॥
+//
+//
+//  बी.स.जी - बोसजी के द्वारा रचित सुरक्षा एवं गोपनीयता हेतु तन्त्राक्ष्।
+// ================================================
+//
+// एक सुरक्षा एवं गोपनीयता केंद्रित तंत्राक्षों का संकलन।
+//
+// एक रचनात्मक भारतीय उत्पाद ।
+//
+// bsg - Boseji's Security and Privacy Utilities
+//
+// A collection of Security and Privacy utilities and some notes for help.
+//
+// This is **Golang** package collection as well as few utility
+// command line programs.
+//
+// Sources
+// -------
+// https://github.com/boseji/bsg
+//
+// License
+// -------
+//
+//   bsg - Boseji's Security and Privacy Utilities.
+//   Copyright (C) 2025-2026 by Abhijit Bose (aka. Boseji)
+//
+//   This program is free software: you can redistribute it and/or modify
+//   it under the terms of the GNU General Public License version 2 only
+//   as published by the Free Software Foundation.
+//
+//   This program is distributed in the hope that it will be useful,
+//   but WITHOUT ANY WARRANTY; without even the implied warranty of
+//   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+//
+//   You should have received a copy of the GNU General Public License
+//   along with this program. If not, see <https://www.gnu.org/licenses/>.
+//
+//  SPDX-License-Identifier: GPL-2.0-only
+//  Full Name: GNU General Public License v2.0 only
+//  Please visit <https://spdx.org/licenses/GPL-2.0-only.html> for details.
+//
+
+package gen_test
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/boseji/bsg/gen"
+)
+
+func TestSignedNRejectsNonPositive(t *testing.T) {
+	g := gen.NewSeededGenerator([32]byte{})
+	if _, err := g.Int64N(-1); !errors.Is(err, gen.ErrInvalidArgument) {
+		t.Errorf("Int64N(-1) error = %v, expected ErrInvalidArgument", err)
+	}
+	if _, err := g.Int32N(-5); !errors.Is(err, gen.ErrInvalidArgument) {
+		t.Errorf("Int32N(-5) error = %v, expected ErrInvalidArgument", err)
+	}
+	if _, err := g.IntN(math.MinInt); !errors.Is(err, gen.ErrInvalidArgument) {
+		t.Errorf("IntN(MinInt) error = %v, expected ErrInvalidArgument", err)
+	}
+
+	for name, fn := range map[string]func(){
+		"Int64N": func() { gen.Int64N(-1) },
+		"Int32N": func() { gen.Int32N(-1) },
+		"IntN":   func() { gen.IntN(-1) },
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("expected panic for gen.%s(-1)", name)
+				}
+			}()
+			fn()
+		}()
+	}
+}
+
+func TestRange(t *testing.T) {
+	for i := 0; i < 1000; i++ {
+		v, err := gen.Range(-3, 3)
+		if err != nil || v < -3 || v > 3 {
+			t.Fatalf("Range(-3, 3) = %d, %v", v, err)
+		}
+		u, err := gen.Range[uint8](250, 255)
+		if err != nil || u < 250 {
+			t.Fatalf("Range[uint8](250, 255) = %d, %v", u, err)
+		}
+	}
+
+	// Both ends must be reachable
+	g := gen.NewSeededGenerator([32]byte{1})
+	seen := map[int16]bool{}
+	for i := 0; i < 1000; i++ {
+		v, _ := gen.RangeFrom[int16](g, -2, 2)
+		seen[v] = true
+	}
+	if len(seen) != 5 {
+		t.Errorf("Range(-2, 2) only produced %v", seen)
+	}
+
+	// Full width ranges must not overflow
+	if _, err := gen.Range[int64](math.MinInt64, math.MaxInt64); err != nil {
+		t.Errorf("full int64 range error: %v", err)
+	}
+	if _, err := gen.Range[uint64](0, math.MaxUint64); err != nil {
+		t.Errorf("full uint64 range error: %v", err)
+	}
+	if v, err := gen.Range(7, 7); err != nil || v != 7 {
+		t.Errorf("Range(7, 7) = %d, %v", v, err)
+	}
+	if _, err := gen.Range(5, 4); !errors.Is(err, gen.ErrInvalidArgument) {
+		t.Errorf("Range(5, 4) error = %v, expected ErrInvalidArgument", err)
+	}
+}
+
+func TestChoice(t *testing.T) {
+	items := []string{"a", "b", "c"}
+	for i := 0; i < 100; i++ {
+		v, err := gen.Choice(items)
+		if err != nil || !containsRune("abc", rune(v[0])) {
+			t.Fatalf("Choice = %q, %v", v, err)
+		}
+	}
+	if _, err := gen.Choice([]int{}); !errors.Is(err, gen.ErrInvalidArgument) {
+		t.Errorf("Choice(empty) error = %v, expected ErrInvalidArgument", err)
+	}
+}
+
+func TestSample(t *testing.T) {
+	items := []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}
+	orig := append([]int(nil), items...)
+
+	s, err := gen.Sample(items, 4)
+	if err != nil || len(s) != 4 {
+		t.Fatalf("Sample = %v, %v", s, err)
+	}
+	seen := map[int]bool{}
+	for _, v := range s {
+		if seen[v] {
+			t.Errorf("Sample repeated %d", v)
+		}
+		seen[v] = true
+	}
+	if !reflect.DeepEqual(items, orig) {
+		t.Errorf("Sample modified the input: %v", items)
+	}
+
+	all, _ := gen.Sample(items, len(items))
+	sort.Ints(all)
+	if !reflect.DeepEqual(all, orig) {
+		t.Errorf("Sample of all items = %v", all)
+	}
+	if s, err := gen.Sample(items, 0); err != nil || len(s) != 0 {
+		t.Errorf("Sample(0) = %v, %v", s, err)
+	}
+	for _, k := range []int{-1, 11} {
+		if _, err := gen.Sample(items, k); !errors.Is(err, gen.ErrInvalidArgument) {
+			t.Errorf("Sample(k=%d) error = %v, expected ErrInvalidArgument", k, err)
+		}
+	}
+}
+
+func TestWeightedChoice(t *testing.T) {
+	g := gen.NewSeededGenerator([32]byte{3})
+	items := []string{"never", "rare", "common"}
+	const draws = 40000
+
+	counts := map[string]int{}
+	for i := 0; i < draws; i++ {
+		v, err := gen.WeightedChoiceFrom(g, items, []uint{0, 1, 3})
+		if err != nil {
+			t.Fatal(err)
+		}
+		counts[v]++
+	}
+	if counts["never"] != 0 {
+		t.Errorf("zero weight item chosen %d times", counts["never"])
+	}
+	if ratio := float64(counts["common"]) / float64(counts["rare"]); ratio < 2.8 || ratio > 3.2 {
+		t.Errorf("integer weights ratio %.2f, expected about 3", ratio)
+	}
+
+	counts = map[string]int{}
+	for i := 0; i < draws; i++ {
+		v, err := gen.WeightedChoiceFrom(g, items, []float64{0, 0.25, 0.75})
+		if err != nil {
+			t.Fatal(err)
+		}
+		counts[v]++
+	}
+	if counts["never"] != 0 {
+		t.Errorf("zero weight item chosen %d times", counts["never"])
+	}
+	if ratio := float64(counts["common"]) / float64(counts["rare"]); ratio < 2.8 || ratio > 3.2 {
+		t.Errorf("float weights ratio %.2f, expected about 3", ratio)
+	}
+}
+
+func TestWeightedChoiceInvalid(t *testing.T) {
+	items := []int{1, 2}
+	tests := []struct {
+		name string
+		fn   func() error
+	}{
+		{"length mismatch", func() error {
+			_, err := gen.WeightedChoice(items, []int{1})
+			return err
+		}},
+		{"empty", func() error {
+			_, err := gen.WeightedChoice([]int{}, []int{})
+			return err
+		}},
+		{"negative int", func() error {
+			_, err := gen.WeightedChoice(items, []int{1, -1})
+			return err
+		}},
+		{"zero total", func() error {
+			_, err := gen.WeightedChoice(items, []int{0, 0})
+			return err
+		}},
+		{"overflow", func() error {
+			_, err := gen.WeightedChoice(items, []uint64{math.MaxUint64, 1})
+			return err
+		}},
+		{"NaN", func() error {
+			_, err := gen.WeightedChoice(items, []float64{1, math.NaN()})
+			return err
+		}},
+		{"Inf", func() error {
+			_, err := gen.WeightedChoice(items, []float32{float32(math.Inf(1)), 1})
+			return err
+		}},
+		{"negative float", func() error {
+			_, err := gen.WeightedChoice(items, []float64{1, -0.5})
+			return err
+		}},
+	}
+	for _, tt := range tests {
+		if err := tt.fn(); !errors.Is(err, gen.ErrInvalidArgument) {
+			t.Errorf("%s: error = %v, expected ErrInvalidArgument", tt.name, err)
+		}
+	}
+}
+
+func TestShuffleSlice(t *testing.T) {
+	data := []string{"a", "b", "c", "d", "e"}
+	if err := gen.ShuffleSlice(data); err != nil {
+		t.Fatal(err)
+	}
+	sorted := append([]string(nil), data...)
+	sort.Strings(sorted)
+	if !reflect.DeepEqual(sorted, []string{"a", "b", "c", "d", "e"}) {
+		t.Errorf("ShuffleSlice lost elements: %v", data)
+	}
+
+	a := []int{1, 2, 3, 4, 5, 6, 7, 8}
+	b := append([]int(nil), a...)
+	gen.ShuffleSliceFrom(gen.NewSeededGenerator([32]byte{5}), a)
+	gen.ShuffleSliceFrom(gen.NewSeededGenerator([32]byte{5}), b)
+	if !reflect.DeepEqual(a, b) {
+		t.Errorf("seeded shuffles differ: %v %v", a, b)
+	}
+}
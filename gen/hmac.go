@@ -0,0 +1,125 @@
+// hmac.go - Part of the `gen` Package
+//
+//     ॐ भूर्भुवः स्वः
+//     तत्स॑वि॒तुर्वरे॑ण्यं॒
+//    भर्गो॑ दे॒वस्य॑ धीमहि।
+//   धियो॒ यो नः॑ प्रचो॒दया॑त्॥
+//
+//
+//  बी.स.जी - बोसजी के द्वारा रचित सुरक्षा एवं गोपनीयता हेतु तन्त्राक्ष्।
+// ================================================
+//
+// एक सुरक्षा एवं गोपनीयता केंद्रित तंत्राक्षों का संकलन।
+//
+// एक रचनात्मक भारतीय उत्पाद ।
+//
+// bsg - Boseji's Security and Privacy Utilities
+//
+// A collection of Security and Privacy utilities and some notes for help.
+//
+// This is **Golang** package collection as well as few utility
+// command line programs.
+//
+// Sources
+// -------
+// https://github.com/boseji/bsg
+//
+// License
+// -------
+//
+//   bsg - Boseji's Security and Privacy Utilities.
+//   Copyright (C) 2025-2026 by Abhijit Bose (aka. Boseji)
+//
+//   This program is free software: you can redistribute it and/or modify
+//   it under the terms of the GNU General Public License version 2 only
+//   as published by the Free Software Foundation.
+//
+//   This program is distributed in the hope that it will be useful,
+//   but WITHOUT ANY WARRANTY; without even the implied warranty of
+//   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+//
+//   You should have received a copy of the GNU General Public License
+//   along with this program. If not, see <https://www.gnu.org/licenses/>.
+//
+//  SPDX-License-Identifier: GPL-2.0-only
+//  Full Name: GNU General Public License v2.0 only
+//  Please visit <https://spdx.org/licenses/GPL-2.0-only.html> for details.
+//
+
+package gen
+
+import (
+	"crypto/hkdf"
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/subtle"
+	"hash"
+)
+
+// HMAC computes the keyed digest of data using the hash constructor.
+func HMAC(h func() hash.Hash, key, data []byte) []byte {
+	mac := hmac.New(h, key)
+	mac.Write(data)
+	return mac.Sum(nil)
+}
+
+// HMACSHA1 computes the HMAC-SHA-1 of input data.
+func HMACSHA1(key, data []byte) []byte {
+	return HMAC(sha1.New, key, data)
+}
+
+// HMACSHA256 computes the HMAC-SHA-256 of input data.
+func HMACSHA256(key, data []byte) []byte {
+	return HMAC(sha256.New, key, data)
+}
+
+// HMACSHA384 computes the HMAC-SHA-384 of input data.
+func HMACSHA384(key, data []byte) []byte {
+	return HMAC(sha512.New384, key, data)
+}
+
+// HMACSHA512 computes the HMAC-SHA-512 of input data.
+func HMACSHA512(key, data []byte) []byte {
+	return HMAC(sha512.New, key, data)
+}
+
+// HMACSHA3_256 computes the HMAC-SHA3-256 of input data.
+func HMACSHA3_256(key, data []byte) []byte {
+	return HMAC(newSHA3_256, key, data)
+}
+
+// HMACSHA3_512 computes the HMAC-SHA3-512 of input data.
+func HMACSHA3_512(key, data []byte) []byte {
+	return HMAC(newSHA3_512, key, data)
+}
+
+// VerifyHMAC checks in constant time that mac is the keyed digest of
+// data using the hash constructor.
+func VerifyHMAC(h func() hash.Hash, key, data, mac []byte) bool {
+	return hmac.Equal(HMAC(h, key, data), mac)
+}
+
+// Equal compares two byte slices in constant time. The time taken
+// depends only on the lengths, never on the contents.
+func Equal(a, b []byte) bool {
+	return subtle.ConstantTimeCompare(a, b) == 1
+}
+
+// HKDFExtract derives a pseudorandom key from the input keying material
+// and an optional salt as per RFC 5869.
+func HKDFExtract(h func() hash.Hash, secret, salt []byte) ([]byte, error) {
+	return hkdf.Extract(h, secret, salt)
+}
+
+// HKDFExpand derives length bytes of output keying material from the
+// pseudorandom key and optional context info as per RFC 5869.
+func HKDFExpand(h func() hash.Hash, prk, info []byte, length int) ([]byte, error) {
+	return hkdf.Expand(h, prk, string(info), length)
+}
+
+// HKDF runs both the extract and expand steps of RFC 5869.
+func HKDF(h func() hash.Hash, secret, salt, info []byte, length int) ([]byte, error) {
+	return hkdf.Key(h, secret, salt, string(info), length)
+}
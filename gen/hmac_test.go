@@ -0,0 +1,237 @@
+// hmac_test.go - Test Program `gen` Package
+//
+//     ॐ भूर्भुवः स्वः
+//     तत्स॑वि॒तुर्वरे॑ण्यं॒
+//    भर्गो॑ दे॒वस्य॑ धीमहि।
+//   धियो॒ यो नः॑ प्रचो॒दया॑त्॥
+//
+//
+//  बी.स.जी - बोसजी के द्वारा रचित सुरक्षा एवं गोपनीयता हेतु तन्त्राक्ष्।
+// ================================================
+//
+// एक सुरक्षा एवं गोपनीयता केंद्रित तंत्राक्षों का संकलन।
+//
+// एक रचनात्मक भारतीय उत्पाद ।
+//
+// bsg - Boseji's Security and Privacy Utilities
+//
+// A collection of Security and Privacy utilities and some notes for help.
+//
+// This is **Golang** package collection as well as few utility
+// command line programs.
+//
+// Sources
+// -------
+// https://github.com/boseji/bsg
+//
+// License
+// -------
+//
+//   bsg - Boseji's Security and Privacy Utilities.
+//   Copyright (C) 2025-2026 by Abhijit Bose (aka. Boseji)
+//
+//   This program is free software: you can redistribute it and/or modify
+//   it under the terms of the GNU General Public License version 2 only
+//   as published by the Free Software Foundation.
+//
+//   This program is distributed in the hope that it will be useful,
+//   but WITHOUT ANY WARRANTY; without even the implied warranty of
+//   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+//
+//   You should have received a copy of the GNU General Public License
+//   along with this program. If not, see <https://www.gnu.org/licenses/>.
+//
+//  SPDX-License-Identifier: GPL-2.0-only
+//  Full Name: GNU General Public License v2.0 only
+//  Please visit <https://spdx.org/licenses/GPL-2.0-only.html> for details.
+//
+
+package gen_test
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"crypto/sha256"
+	"hash"
+	"testing"
+
+	"github.com/boseji/bsg/gen"
+)
+
+// TestHMAC uses test case 2 of RFC 2202 and RFC 4231 along with the
+// same key and data for SHA-3.
+func TestHMAC(t *testing.T) {
+	key := []byte("Jefe")
+	data := []byte("what do ya want for nothing?")
+
+	tests := []struct {
+		name     string
+		fn       func(key, data []byte) []byte
+		expected string
+	}{
+		{"HMACSHA1", gen.HMACSHA1,
+			"effcdf6ae5eb2fa2d27416d5f184df9c259a7c79"},
+		{"HMACSHA256", gen.HMACSHA256,
+			"5bdcc146bf60754e6a042426089575c75a003f089d2739839dec58b964ec3843"},
+		{"HMACSHA384", gen.HMACSHA384,
+			"af45d2e376484031617f78d2b58a6b1b9c7ef464f5a01b47" +
+				"e42ec3736322445e8e2240ca5e69e2c78b3239ecfab21649"},
+		{"HMACSHA512", gen.HMACSHA512,
+			"164b7a7bfcf819e2e395fbe73b56e0a387bd64222e831fd6" +
+				"10270cd7ea2505549758bf75c05a994a6d034f65f8f0e6fd" +
+				"caeab1a34d4a6b4b636e070a38bce737"},
+		{"HMACSHA3_256", gen.HMACSHA3_256,
+			"c7d4072e788877ae3596bbb0da73b887c9171f93095b294ae857fbe2645e1ba5"},
+		{"HMACSHA3_512", gen.HMACSHA3_512,
+			"5a4bfeab6166427c7a3647b747292b8384537cdb89afb3bf" +
+				"5665e4c5e709350b287baec921fd7ca0ee7a0c31d022a95e" +
+				"1fc92ba9d77df883960275beb4e62024"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output := tt.fn(key, data)
+			expected := fromHex(t, tt.expected)
+			if !bytes.Equal(output, expected) {
+				t.Errorf("%s failed: got %x, expected %x", tt.name, output, expected)
+			}
+		})
+	}
+}
+
+func TestVerifyHMAC(t *testing.T) {
+	key := []byte("Jefe")
+	data := []byte("what do ya want for nothing?")
+	mac := gen.HMACSHA256(key, data)
+
+	if !gen.VerifyHMAC(sha256.New, key, data, mac) {
+		t.Error("VerifyHMAC rejected a valid MAC")
+	}
+	mac[0] ^= 1
+	if gen.VerifyHMAC(sha256.New, key, data, mac) {
+		t.Error("VerifyHMAC accepted a modified MAC")
+	}
+	if gen.VerifyHMAC(sha256.New, key, data, mac[:16]) {
+		t.Error("VerifyHMAC accepted a truncated MAC")
+	}
+}
+
+func TestEqual(t *testing.T) {
+	tests := []struct {
+		a, b     []byte
+		expected bool
+	}{
+		{[]byte("abc"), []byte("abc"), true},
+		{[]byte("abc"), []byte("abd"), false},
+		{[]byte("abc"), []byte("ab"), false},
+		{nil, []byte{}, true},
+	}
+	for _, tt := range tests {
+		if got := gen.Equal(tt.a, tt.b); got != tt.expected {
+			t.Errorf("Equal(%q, %q) = %v, expected %v", tt.a, tt.b, got, tt.expected)
+		}
+	}
+}
+
+// TestHKDF uses the test vectors of RFC 5869 Appendix A.
+func TestHKDF(t *testing.T) {
+	tests := []struct {
+		name string
+		hash func() hash.Hash
+		ikm  string
+		salt string
+		info string
+		l    int
+		prk  string
+		okm  string
+	}{
+		{
+			name: "case 1 SHA-256",
+			hash: sha256.New,
+			ikm:  "0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b",
+			salt: "000102030405060708090a0b0c",
+			info: "f0f1f2f3f4f5f6f7f8f9",
+			l:    42,
+			prk:  "077709362c2e32df0ddc3f0dc47bba6390b6c73bb50f9c3122ec844ad7c2b3e5",
+			okm: "3cb25f25faacd57a90434f64d0362f2a2d2d0a90cf1a5a4c5db02d56ecc4c5bf" +
+				"34007208d5b887185865",
+		},
+		{
+			name: "case 2 SHA-256 longer inputs",
+			hash: sha256.New,
+			ikm: "000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f" +
+				"202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f" +
+				"404142434445464748494a4b4c4d4e4f",
+			salt: "606162636465666768696a6b6c6d6e6f707172737475767778797a7b7c7d7e7f" +
+				"808182838485868788898a8b8c8d8e8f909192939495969798999a9b9c9d9e9f" +
+				"a0a1a2a3a4a5a6a7a8a9aaabacadaeaf",
+			info: "b0b1b2b3b4b5b6b7b8b9babbbcbdbebfc0c1c2c3c4c5c6c7c8c9cacbcccdcecf" +
+				"d0d1d2d3d4d5d6d7d8d9dadbdcdddedfe0e1e2e3e4e5e6e7e8e9eaebecedeeef" +
+				"f0f1f2f3f4f5f6f7f8f9fafbfcfdfeff",
+			l:   82,
+			prk: "06a6b88c5853361a06104c9ceb35b45cef760014904671014a193f40c15fc244",
+			okm: "b11e398dc80327a1c8e7f78c596a49344f012eda2d4efad8a050cc4c19afa97c" +
+				"59045a99cac7827271cb41c65e590e09da3275600c2f09b8367793a9aca3db71" +
+				"cc30c58179ec3e87c14c01d5c1f3434f1d87",
+		},
+		{
+			name: "case 3 SHA-256 zero length salt and info",
+			hash: sha256.New,
+			ikm:  "0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b",
+			l:    42,
+			prk:  "19ef24a32c717b167f33a91d6f648bdf96596776afdb6377ac434c1c293ccb04",
+			okm: "8da4e775a563c18f715f802a063c5a31b8a11f5c5ee1879ec3454e5f3c738d2d" +
+				"9d201395faa4b61a96c8",
+		},
+		{
+			name: "case 4 SHA-1",
+			hash: sha1.New,
+			ikm:  "0b0b0b0b0b0b0b0b0b0b0b",
+			salt: "000102030405060708090a0b0c",
+			info: "f0f1f2f3f4f5f6f7f8f9",
+			l:    42,
+			prk:  "9b6c18c432a7bf8f0e71c8eb88f4b30baa2ba243",
+			okm: "085a01ea1b10f36933068b56efa5ad81a4f14b822f5b091568a9cdd4f155fda2" +
+				"c22e422478d305f3f896",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ikm := fromHex(t, tt.ikm)
+			salt := fromHex(t, tt.salt)
+			info := fromHex(t, tt.info)
+
+			prk, err := gen.HKDFExtract(tt.hash, ikm, salt)
+			if err != nil {
+				t.Fatalf("HKDFExtract error: %v", err)
+			}
+			if !bytes.Equal(prk, fromHex(t, tt.prk)) {
+				t.Errorf("PRK = %x, expected %s", prk, tt.prk)
+			}
+
+			okm, err := gen.HKDFExpand(tt.hash, prk, info, tt.l)
+			if err != nil {
+				t.Fatalf("HKDFExpand error: %v", err)
+			}
+			if !bytes.Equal(okm, fromHex(t, tt.okm)) {
+				t.Errorf("OKM = %x, expected %s", okm, tt.okm)
+			}
+
+			okm, err = gen.HKDF(tt.hash, ikm, salt, info, tt.l)
+			if err != nil {
+				t.Fatalf("HKDF error: %v", err)
+			}
+			if !bytes.Equal(okm, fromHex(t, tt.okm)) {
+				t.Errorf("HKDF = %x, expected %s", okm, tt.okm)
+			}
+		})
+	}
+}
+
+func TestHKDFTooLong(t *testing.T) {
+	prk := make([]byte, 32)
+	if _, err := gen.HKDFExpand(sha256.New, prk, nil, 255*32+1); err == nil {
+		t.Error("expected error for output longer than 255 blocks")
+	}
+}
@@ -0,0 +1,157 @@
+// ksuid.go - Part of the `gen` Package
+//
+//     ॐ भूर्भुवः स्वः
+//     तत्स॑वि॒तुर्वरे॑ण्यं॒
+//    भर्गो॑ दे॒वस्य॑ धीमहि।
+//   धियो॒ यो नः॑ प्रचो॒दया॑त्॥
+//
+//
+//  बी.स.जी - बोसजी के द्वारा रचित सुरक्षा एवं गोपनीयता हेतु तन्त्राक्ष्।
+// ================================================
+//
+// एक सुरक्षा एवं गोपनीयता केंद्रित तंत्राक्षों का संकलन।
+//
+// एक रचनात्मक भारतीय उत्पाद ।
+//
+// bsg - Boseji's Security and Privacy Utilities
+//
+// A collection of Security and Privacy utilities and some notes for help.
+//
+// This is **Golang** package collection as well as few utility
+// command line programs.
+//
+// Sources
+// -------
+// https://github.com/boseji/bsg
+//
+// License
+// -------
+//
+//   bsg - Boseji's Security and Privacy Utilities.
+//   Copyright (C) 2025-2026 by Abhijit Bose (aka. Boseji)
+//
+//   This program is free software: you can redistribute it and/or modify
+//   it under the terms of the GNU General Public License version 2 only
+//   as published by the Free Software Foundation.
+//
+//   This program is distributed in the hope that it will be useful,
+//   but WITHOUT ANY WARRANTY; without even the implied warranty of
+//   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+//
+//   You should have received a copy of the GNU General Public License
+//   along with this program. If not, see <https://www.gnu.org/licenses/>.
+//
+//  SPDX-License-Identifier: GPL-2.0-only
+//  Full Name: GNU General Public License v2.0 only
+//  Please visit <https://spdx.org/licenses/GPL-2.0-only.html> for details.
+//
+
+package gen
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+var ErrInvalidKSUID = errors.New("invalid KSUID")
+
+// KSUIDEpoch is the start of the KSUID timestamps, 13 May 2014.
+const KSUIDEpoch = 1400000000
+
+// KSUID is a K-Sortable Unique IDentifier: a 32 bit timestamp in seconds
+// since KSUIDEpoch followed by a 128 bit random payload.
+// See https://github.com/segmentio/ksuid
+type KSUID [20]byte
+
+// base62Alphabet orders digits before letters so the text sorts the
+// same way as the bytes.
+const base62Alphabet = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+
+// ksuidLength is the length of the base62 text form.
+const ksuidLength = 27
+
+// NewKSUID generates a KSUID for the current time.
+func NewKSUID() (KSUID, error) {
+	var k KSUID
+	if _, err := defaultGenerator.Read(k[4:]); err != nil {
+		return KSUID{}, err
+	}
+	binary.BigEndian.PutUint32(k[:4], uint32(time.Now().Unix()-KSUIDEpoch))
+	return k, nil
+}
+
+// ParseKSUID parses the 27 character base62 form.
+func ParseKSUID(s string) (KSUID, error) {
+	if len(s) != ksuidLength {
+		return KSUID{}, fmt.Errorf("%w: %q has wrong length", ErrInvalidKSUID, s)
+	}
+	var k KSUID
+	for i := 0; i < len(s); i++ {
+		d := strings.IndexByte(base62Alphabet, s[i])
+		if d < 0 {
+			return KSUID{}, fmt.Errorf("%w: %q has bad character", ErrInvalidKSUID, s)
+		}
+		// k = k*62 + d
+		carry := uint(d)
+		for j := len(k) - 1; j >= 0; j-- {
+			v := uint(k[j])*62 + carry
+			k[j] = byte(v)
+			carry = v >> 8
+		}
+		if carry != 0 {
+			return KSUID{}, fmt.Errorf("%w: %q overflows 160 bits", ErrInvalidKSUID, s)
+		}
+	}
+	return k, nil
+}
+
+// ValidKSUID reports if s is a well formed KSUID.
+func ValidKSUID(s string) bool {
+	_, err := ParseKSUID(s)
+	return err == nil
+}
+
+// String returns the 27 character base62 form.
+func (k KSUID) String() string {
+	n := k
+	var b [ksuidLength]byte
+	for i := len(b) - 1; i >= 0; i-- {
+		// n, rem = n/62, n%62
+		var rem uint
+		for j := range n {
+			v := rem<<8 | uint(n[j])
+			n[j] = byte(v / 62)
+			rem = v % 62
+		}
+		b[i] = base62Alphabet[rem]
+	}
+	return string(b[:])
+}
+
+// Time returns the timestamp of the KSUID.
+func (k KSUID) Time() time.Time {
+	return time.Unix(int64(binary.BigEndian.Uint32(k[:4]))+KSUIDEpoch, 0)
+}
+
+// Payload returns the 16 random bytes of the KSUID.
+func (k KSUID) Payload() []byte {
+	return append([]byte(nil), k[4:]...)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (k KSUID) MarshalText() ([]byte, error) {
+	return []byte(k.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (k *KSUID) UnmarshalText(b []byte) error {
+	v, err := ParseKSUID(string(b))
+	if err != nil {
+		return err
+	}
+	*k = v
+	return nil
+}
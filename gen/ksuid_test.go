@@ -0,0 +1,119 @@
+// ksuid_test.go - Test Program `gen` Package
+//
+//     ॐ भूर्भुवः स्वः
+//     तत्स॑वि॒तुर्वरे॑ण्यं॒
+//    भर्गो॑ दे॒वस्य॑ धीमहि।
+//   धियो॒ यो नः॑ प्रचो॒दया॑त्॥
+//
+//
+//  बी.स.जी - बोसजी के द्वारा रचित सुरक्षा एवं गोपनीयता हेतु तन्त्राक्ष्।
+// ================================================
+//
+// एक सुरक्षा एवं गोपनीयता केंद्रित तंत्राक्षों का संकलन।
+//
+// एक रचनात्मक भारतीय उत्पाद ।
+//
+// bsg - Boseji's Security and Privacy Utilities
+//
+// A collection of Security and Privacy utilities and some notes for help.
+//
+// This is **Golang** package collection as well as few utility
+// command line programs.
+//
+// Sources
+// -------
+// https://github.com/boseji/bsg
+//
+// License
+// -------
+//
+//   bsg - Boseji's Security and Privacy Utilities.
+//   Copyright (C) 2025-2026 by Abhijit Bose (aka. Boseji)
+//
+//   This program is free software: you can redistribute it and/or modify
+//   it under the terms of the GNU General Public License version 2 only
+//   as published by the Free Software Foundation.
+//
+//   This program is distributed in the hope that it will be useful,
+//   but WITHOUT ANY WARRANTY; without even the implied warranty of
+//   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+//
+//   You should have received a copy of the GNU General Public License
+//   along with this program. If not, see <https://www.gnu.org/licenses/>.
+//
+//  SPDX-License-Identifier: GPL-2.0-only
+//  Full Name: GNU General Public License v2.0 only
+//  Please visit <https://spdx.org/licenses/GPL-2.0-only.html> for details.
+//
+
+package gen_test
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/boseji/bsg/gen"
+)
+
+// TestKSUIDExample uses the example from the KSUID reference
+// implementation.
+func TestKSUIDExample(t *testing.T) {
+	k, err := gen.ParseKSUID("0ujtsYcgvSTl8PAuAdqWYSMnLOv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ts := k.Time().UTC(); !ts.Equal(time.Date(2017, 10, 10, 4, 0, 47, 0, time.UTC)) {
+		t.Errorf("Time() = %v", ts)
+	}
+	expected := fromHex(t, "b5a1cd34b5f99d1154fb6853345c9735")
+	if !bytes.Equal(k.Payload(), expected) {
+		t.Errorf("Payload() = %x, expected %x", k.Payload(), expected)
+	}
+	if k.String() != "0ujtsYcgvSTl8PAuAdqWYSMnLOv" {
+		t.Errorf("round trip gave %s", k)
+	}
+}
+
+func TestKSUIDLimits(t *testing.T) {
+	var zero gen.KSUID
+	if zero.String() != "000000000000000000000000000" {
+		t.Errorf("zero KSUID = %s", zero)
+	}
+	max, err := gen.ParseKSUID("aWgEPTl1tmebfsQzFP4bxwgy80V")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(max[:], bytes.Repeat([]byte{0xff}, 20)) {
+		t.Errorf("max KSUID decoded to %x", max)
+	}
+
+	for _, in := range []string{
+		"aWgEPTl1tmebfsQzFP4bxwgy80W",
+		"0ujtsYcgvSTl8PAuAdqWYSMnLO",
+		"0ujtsYcgvSTl8PAuAdqWYSMnLO!",
+	} {
+		if _, err := gen.ParseKSUID(in); !errors.Is(err, gen.ErrInvalidKSUID) {
+			t.Errorf("ParseKSUID(%q) error = %v, expected ErrInvalidKSUID", in, err)
+		}
+	}
+}
+
+func TestNewKSUID(t *testing.T) {
+	before := time.Now().Add(-time.Second)
+	k, err := gen.NewKSUID()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ts := k.Time(); ts.Before(before) || ts.After(time.Now()) {
+		t.Errorf("KSUID time %v outside expected window", ts)
+	}
+	if !gen.ValidKSUID(k.String()) {
+		t.Errorf("ValidKSUID(%s) = false", k)
+	}
+	var back gen.KSUID
+	if err := back.UnmarshalText([]byte(k.String())); err != nil || back != k {
+		t.Errorf("UnmarshalText = %s, %v", back, err)
+	}
+}
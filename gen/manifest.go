@@ -0,0 +1,496 @@
+// manifest.go - Part of the `gen` Package
+//
+//     ॐ भूर्भुवः स्वः
+//     तत्स॑वि॒तुर्वरे॑ण्यं॒
+//    भर्गो॑ दे॒वस्य॑ धीमहि।
+//   धियो॒ यो नः॑ प्रचो॒दया॑त्॥
+//
+//
+//  बी.स.जी - बोसजी के द्वारा रचित सुरक्षा एवं गोपनीयता हेतु तन्त्राक्ष्।
+// ================================================
+//
+// एक सुरक्षा एवं गोपनीयता केंद्रित तंत्राक्षों का संकलन।
+//
+// एक रचनात्मक भारतीय उत्पाद ।
+//
+// bsg - Boseji's Security and Privacy Utilities
+//
+// A collection of Security and Privacy utilities and some notes for help.
+//
+// This is **Golang** package collection as well as few utility
+// command line programs.
+//
+// Sources
+// -------
+// https://github.com/boseji/bsg
+//
+// License
+// -------
+//
+//   bsg - Boseji's Security and Privacy Utilities.
+//   Copyright (C) 2025-2026 by Abhijit Bose (aka. Boseji)
+//
+//   This program is free software: you can redistribute it and/or modify
+//   it under the terms of the GNU General Public License version 2 only
+//   as published by the Free Software Foundation.
+//
+//   This program is distributed in the hope that it will be useful,
+//   but WITHOUT ANY WARRANTY; without even the implied warranty of
+//   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+//
+//   You should have received a copy of the GNU General Public License
+//   along with this program. If not, see <https://www.gnu.org/licenses/>.
+//
+//  SPDX-License-Identifier: GPL-2.0-only
+//  Full Name: GNU General Public License v2.0 only
+//  Please visit <https://spdx.org/licenses/GPL-2.0-only.html> for details.
+//
+
+package gen
+
+import (
+	"bufio"
+	"context"
+	"crypto/subtle"
+	hx "encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+)
+
+var ErrInvalidManifest = errors.New("invalid manifest")
+
+// ManifestFormat selects the line format of a checksum manifest.
+type ManifestFormat int
+
+const (
+	// FormatGNU is the GNU coreutils format, "<hex>  <file>", with a
+	// "*" in front of the file for binary mode. Used by sha256sum.
+	FormatGNU ManifestFormat = iota
+	// FormatBSD is the BSD tagged format, "SHA256 (<file>) = <hex>".
+	FormatBSD
+)
+
+// ManifestEntry is one file in a checksum manifest.
+type ManifestEntry struct {
+	Path      string    // Slash separated path relative to the root.
+	Sum       []byte    // Expected digest of the file.
+	Algorithm Algorithm // Algorithm that produced the digest.
+	Binary    bool      // Binary mode marker of the GNU format.
+}
+
+// Manifest is a list of files with their expected digests.
+type Manifest struct {
+	Entries []ManifestEntry
+}
+
+// ManifestReport is the outcome of verifying a manifest.
+type ManifestReport struct {
+	OK         []string         // Files whose digest matched.
+	Missing    []string         // Files in the manifest but not on disk.
+	Mismatched []string         // Files whose digest did not match.
+	Extra      []string         // Files on disk but not in the manifest.
+	Errors     map[string]error // Files that could not be read.
+}
+
+// Valid reports if every file in the manifest was present and matched.
+// Extra files do not make a manifest invalid, as with sha256sum -c.
+func (r *ManifestReport) Valid() bool {
+	return len(r.Missing) == 0 && len(r.Mismatched) == 0 && len(r.Errors) == 0
+}
+
+// ManifestOptions holds configuration parameters for manifests.
+type ManifestOptions struct {
+	Workers int             // Files hashed in parallel (default is NumCPU).
+	Binary  bool            // Mark entries as binary mode.
+	Exclude map[string]bool // Slash separated paths to leave out.
+}
+
+// ManifestOption is a function that modifies ManifestOptions.
+type ManifestOption func(*ManifestOptions)
+
+// WithWorkers sets the number of files hashed in parallel.
+func WithWorkers(n int) ManifestOption {
+	return func(opts *ManifestOptions) {
+		opts.Workers = n
+	}
+}
+
+// WithBinary marks created entries with the binary "*" marker.
+func WithBinary(binary bool) ManifestOption {
+	return func(opts *ManifestOptions) {
+		opts.Binary = binary
+	}
+}
+
+// WithExclude leaves the given paths, relative to the root, out of the
+// manifest and out of the extra files. Useful for the manifest itself.
+func WithExclude(paths ...string) ManifestOption {
+	return func(opts *ManifestOptions) {
+		for _, p := range paths {
+			opts.Exclude[filepath.ToSlash(filepath.Clean(p))] = true
+		}
+	}
+}
+
+// CreateManifest walks the directory root and hashes every regular file
+// with the algorithm, using a pool of workers.
+func CreateManifest(ctx context.Context, root string, alg Algorithm,
+	opts ...ManifestOption) (*Manifest, error) {
+	options := manifestOptions(opts)
+	paths, err := walkFiles(root, options.Exclude)
+	if err != nil {
+		return nil, err
+	}
+
+	jobs := make([]ManifestEntry, len(paths))
+	for i, p := range paths {
+		jobs[i] = ManifestEntry{Path: p, Algorithm: alg, Binary: options.Binary}
+	}
+	errs := hashEntries(ctx, root, jobs, options.Workers)
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	for i, err := range errs {
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", jobs[i].Path, err)
+		}
+	}
+	return &Manifest{Entries: jobs}, nil
+}
+
+// VerifyManifest hashes the files listed in the manifest relative to
+// root and reports missing, mismatched and extra files separately.
+// Entries that point outside root, such as "../x" or absolute paths, are
+// never read and are reported in Errors with ErrInvalidManifest. Nor is
+// a file reached through a symlink that leads out of root.
+func VerifyManifest(ctx context.Context, m *Manifest, root string,
+	opts ...ManifestOption) (*ManifestReport, error) {
+	options := manifestOptions(opts)
+
+	listed := make(map[string]bool, len(m.Entries))
+	jobs := make([]ManifestEntry, len(m.Entries))
+	for i, e := range m.Entries {
+		listed[filepath.ToSlash(filepath.Clean(filepath.FromSlash(e.Path)))] = true
+		jobs[i] = ManifestEntry{Path: e.Path, Algorithm: e.Algorithm}
+	}
+	errs := hashEntries(ctx, root, jobs, options.Workers)
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	report := &ManifestReport{Errors: map[string]error{}}
+	for i, e := range m.Entries {
+		switch {
+		case errors.Is(errs[i], fs.ErrNotExist):
+			report.Missing = append(report.Missing, e.Path)
+		case errs[i] != nil:
+			report.Errors[e.Path] = errs[i]
+		case subtle.ConstantTimeCompare(jobs[i].Sum, e.Sum) == 1:
+			report.OK = append(report.OK, e.Path)
+		default:
+			report.Mismatched = append(report.Mismatched, e.Path)
+		}
+	}
+
+	paths, err := walkFiles(root, options.Exclude)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range paths {
+		if !listed[p] {
+			report.Extra = append(report.Extra, p)
+		}
+	}
+	return report, nil
+}
+
+// Encode writes the manifest in the chosen format.
+func (m *Manifest) Encode(w io.Writer, format ManifestFormat) error {
+	bw := bufio.NewWriter(w)
+	for _, e := range m.Entries {
+		var line string
+		switch format {
+		case FormatGNU:
+			name, escaped := escapeManifestPath(e.Path)
+			mode := " "
+			if e.Binary {
+				mode = "*"
+			}
+			prefix := ""
+			if escaped {
+				prefix = `\`
+			}
+			line = prefix + hx.EncodeToString(e.Sum) + " " + mode + name
+		case FormatBSD:
+			name, escaped := escapeManifestPath(e.Path)
+			prefix := ""
+			if escaped {
+				prefix = `\`
+			}
+			line = prefix + strings.ToUpper(e.Algorithm.Name) +
+				" (" + name + ") = " + hx.EncodeToString(e.Sum)
+		default:
+			return fmt.Errorf("unknown manifest format %d", format)
+		}
+		if _, err := bw.WriteString(line + "\n"); err != nil {
+			return err
+		}
+	}
+	return bw.Flush()
+}
+
+// ParseManifest reads a manifest in either format, detected per line.
+// GNU lines use alg; if alg has no constructor the algorithm is guessed
+// from the digest length as sha1, sha256, sha384 or sha512.
+// Blank lines and lines starting with "#" are skipped.
+func ParseManifest(r io.Reader, alg Algorithm) (*Manifest, error) {
+	m := &Manifest{}
+	sc := bufio.NewScanner(r)
+	sc.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for n := 1; sc.Scan(); n++ {
+		line := strings.TrimRight(sc.Text(), "\r")
+		if strings.TrimSpace(line) == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		e, err := parseManifestLine(line, alg)
+		if err != nil {
+			return nil, fmt.Errorf("%w: line %d: %v", ErrInvalidManifest, n, err)
+		}
+		m.Entries = append(m.Entries, e)
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// ReadManifest reads and parses the manifest file at path.
+func ReadManifest(path string, alg Algorithm) (*Manifest, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return ParseManifest(f, alg)
+}
+
+// parseManifestLine decodes a single GNU or BSD manifest line.
+func parseManifestLine(line string, alg Algorithm) (ManifestEntry, error) {
+	escaped := strings.HasPrefix(line, `\`)
+	if escaped {
+		line = line[1:]
+	}
+
+	// BSD: NAME (file) = hex
+	if i := strings.Index(line, " ("); i > 0 && !strings.Contains(line[:i], " ") {
+		j := strings.LastIndex(line, ") = ")
+		if j < i {
+			return ManifestEntry{}, fmt.Errorf("malformed BSD line")
+		}
+		a, err := ParseAlgorithm(line[:i])
+		if err != nil {
+			return ManifestEntry{}, err
+		}
+		sum, err := hx.DecodeString(line[j+4:])
+		if err != nil {
+			return ManifestEntry{}, err
+		}
+		if a.Size != len(sum) {
+			if !isSHAKE(line[:i]) || strings.Contains(line[:i], "/") {
+				return ManifestEntry{}, fmt.Errorf("%s needs %d bytes", a.Name, a.Size)
+			}
+			a, _ = ParseAlgorithm(fmt.Sprintf("%s/%d", line[:i], len(sum)))
+		}
+		name, err := unescapeManifestPath(line[i+2:j], escaped)
+		if err != nil {
+			return ManifestEntry{}, err
+		}
+		return ManifestEntry{Path: name, Sum: sum, Algorithm: a}, nil
+	}
+
+	// GNU: hex  file  or  hex *file
+	hexSum, rest, ok := strings.Cut(line, " ")
+	if !ok || len(rest) < 2 || (rest[0] != ' ' && rest[0] != '*') {
+		return ManifestEntry{}, fmt.Errorf("malformed GNU line")
+	}
+	sum, err := hx.DecodeString(hexSum)
+	if err != nil {
+		return ManifestEntry{}, err
+	}
+	a := alg
+	if a.New == nil {
+		a, err = guessAlgorithm(len(sum))
+		if err != nil {
+			return ManifestEntry{}, err
+		}
+	}
+	if a.Size != len(sum) {
+		return ManifestEntry{}, fmt.Errorf("%s needs %d bytes", a.Name, a.Size)
+	}
+	name, err := unescapeManifestPath(rest[1:], escaped)
+	if err != nil {
+		return ManifestEntry{}, err
+	}
+	return ManifestEntry{Path: name, Sum: sum, Algorithm: a, Binary: rest[0] == '*'}, nil
+}
+
+// guessAlgorithm picks the SHA-2 family member (or SHA-1) matching the
+// digest size, the same way the coreutils tools would be chosen.
+func guessAlgorithm(size int) (Algorithm, error) {
+	names := map[int]string{20: "sha1", 32: "sha256", 48: "sha384", 64: "sha512"}
+	name, ok := names[size]
+	if !ok {
+		return Algorithm{}, fmt.Errorf("%w: no algorithm with %d byte digests",
+			ErrUnknownAlgorithm, size)
+	}
+	return ParseAlgorithm(name)
+}
+
+// escapeManifestPath escapes backslashes and newlines as coreutils does.
+func escapeManifestPath(p string) (string, bool) {
+	if !strings.ContainsAny(p, "\\\n\r") {
+		return p, false
+	}
+	r := strings.NewReplacer(`\`, `\\`, "\n", `\n`, "\r", `\r`)
+	return r.Replace(p), true
+}
+
+// unescapeManifestPath reverses escapeManifestPath.
+func unescapeManifestPath(p string, escaped bool) (string, error) {
+	if !escaped {
+		return p, nil
+	}
+	var b strings.Builder
+	for i := 0; i < len(p); i++ {
+		if p[i] != '\\' {
+			b.WriteByte(p[i])
+			continue
+		}
+		i++
+		if i == len(p) {
+			return "", fmt.Errorf("dangling escape in %q", p)
+		}
+		switch p[i] {
+		case '\\':
+			b.WriteByte('\\')
+		case 'n':
+			b.WriteByte('\n')
+		case 'r':
+			b.WriteByte('\r')
+		default:
+			return "", fmt.Errorf("unknown escape in %q", p)
+		}
+	}
+	return b.String(), nil
+}
+
+// manifestOptions applies the options over the defaults.
+func manifestOptions(opts []ManifestOption) ManifestOptions {
+	options := ManifestOptions{
+		Workers: runtime.NumCPU(),
+		Exclude: map[string]bool{},
+	}
+	for _, opt := range opts {
+		opt(&options)
+	}
+	if options.Workers < 1 {
+		options.Workers = 1
+	}
+	return options
+}
+
+// walkFiles lists the regular files under root as sorted slash
+// separated relative paths. Symbolic links are not followed.
+func walkFiles(root string, exclude map[string]bool) ([]string, error) {
+	var paths []string
+	err := filepath.WalkDir(root, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		rel, err := filepath.Rel(root, p)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+		if !exclude[rel] {
+			paths = append(paths, rel)
+		}
+		return nil
+	})
+	sort.Strings(paths)
+	return paths, err
+}
+
+// sumRootFile computes the digest of the file at rel inside dir.
+func sumRootFile(ctx context.Context, dir *os.Root, rel string,
+	h func() hash.Hash) ([]byte, error) {
+	f, err := dir.Open(rel)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return SumReader(ctx, f, h)
+}
+
+// hashEntries fills in the Sum of every entry using a pool of workers.
+// The returned slice holds the error, if any, for each entry.
+func hashEntries(ctx context.Context, root string, entries []ManifestEntry,
+	workers int) []error {
+	errs := make([]error, len(entries))
+	// Opening through an os.Root also refuses symlinks that lead out
+	// of root, which the lexical check below cannot see.
+	dir, err := os.OpenRoot(root)
+	if err != nil {
+		for i := range errs {
+			errs[i] = err
+		}
+		return errs
+	}
+	defer dir.Close()
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				e := &entries[i]
+				if e.Algorithm.New == nil {
+					errs[i] = ErrUnknownAlgorithm
+					continue
+				}
+				// Paths may come from an untrusted manifest; never
+				// hash a file outside root.
+				rel := filepath.FromSlash(e.Path)
+				if !filepath.IsLocal(rel) {
+					errs[i] = fmt.Errorf("%w: %q is outside the root",
+						ErrInvalidManifest, e.Path)
+					continue
+				}
+				e.Sum, errs[i] = sumRootFile(ctx, dir, rel, e.Algorithm.New)
+			}
+		}()
+	}
+
+	for i := range entries {
+		if ctx.Err() != nil {
+			break
+		}
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+	return errs
+}
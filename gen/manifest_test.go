@@ -0,0 +1,344 @@
+// manifest_test.go - Test Program `gen` Package
+//
+//     ॐ भूर्भुवः स्वः
+//     तत्स॑वि॒तुर्वरे॑ण्यं॒
+//    भर्गो॑ दे॒वस्य॑ धीमहि।
+//   धियो॒ यो नः॑ प्रचो॒दया॑त्॥
+//
+//
+//  बी.स.जी - बोसजी के द्वारा रचित सुरक्षा एवं गोपनीयता हेतु तन्त्राक्ष्।
+// ================================================
+//
+// एक सुरक्षा एवं गोपनीयता केंद्रित तंत्राक्षों का संकलन।
+//
+// एक रचनात्मक भारतीय उत्पाद ।
+//
+// bsg - Boseji's Security and Privacy Utilities
+//
+// A collection of Security and Privacy utilities and some notes for help.
+//
+// This is **Golang** package collection as well as few utility
+// command line programs.
+//
+// Sources
+// -------
+// https://github.com/boseji/bsg
+//
+// License
+// -------
+//
+//   bsg - Boseji's Security and Privacy Utilities.
+//   Copyright (C) 2025-2026 by Abhijit Bose (aka. Boseji)
+//
+//   This program is free software: you can redistribute it and/or modify
+//   it under the terms of the GNU General Public License version 2 only
+//   as published by the Free Software Foundation.
+//
+//   This program is distributed in the hope that it will be useful,
+//   but WITHOUT ANY WARRANTY; without even the implied warranty of
+//   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+//
+//   You should have received a copy of the GNU General Public License
+//   along with this program. If not, see <https://www.gnu.org/licenses/>.
+//
+//  SPDX-License-Identifier: GPL-2.0-only
+//  Full Name: GNU General Public License v2.0 only
+//  Please visit <https://spdx.org/licenses/GPL-2.0-only.html> for details.
+//
+
+package gen_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/boseji/bsg/gen"
+)
+
+const abcSHA256 = "ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad"
+
+// writeTree creates the files under a temporary root.
+func writeTree(t *testing.T, files map[string]string) string {
+	root := t.TempDir()
+	for name, data := range files {
+		p := filepath.Join(root, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(p), 0o700); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(p, []byte(data), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return root
+}
+
+func TestManifestEncode(t *testing.T) {
+	root := writeTree(t, map[string]string{
+		"abc.txt":     "abc",
+		"sub/abc.bin": "abc",
+	})
+	alg, _ := gen.ParseAlgorithm("sha256")
+
+	m, err := gen.CreateManifest(context.Background(), root, alg, gen.WithWorkers(2))
+	if err != nil {
+		t.Fatalf("CreateManifest failed: %v", err)
+	}
+
+	var gnu bytes.Buffer
+	if err := m.Encode(&gnu, gen.FormatGNU); err != nil {
+		t.Fatal(err)
+	}
+	expected := abcSHA256 + "  abc.txt\n" + abcSHA256 + "  sub/abc.bin\n"
+	if gnu.String() != expected {
+		t.Errorf("GNU manifest:\n%s\nexpected:\n%s", gnu.String(), expected)
+	}
+
+	var bsd bytes.Buffer
+	if err := m.Encode(&bsd, gen.FormatBSD); err != nil {
+		t.Fatal(err)
+	}
+	expected = "SHA256 (abc.txt) = " + abcSHA256 + "\n" +
+		"SHA256 (sub/abc.bin) = " + abcSHA256 + "\n"
+	if bsd.String() != expected {
+		t.Errorf("BSD manifest:\n%s\nexpected:\n%s", bsd.String(), expected)
+	}
+
+	m, _ = gen.CreateManifest(context.Background(), root, alg, gen.WithBinary(true),
+		gen.WithExclude("sub/abc.bin"))
+	gnu.Reset()
+	m.Encode(&gnu, gen.FormatGNU)
+	if gnu.String() != abcSHA256+" *abc.txt\n" {
+		t.Errorf("binary GNU manifest = %q", gnu.String())
+	}
+}
+
+func TestParseManifest(t *testing.T) {
+	input := "# comment\n" +
+		abcSHA256 + "  abc.txt\n" +
+		abcSHA256 + " *bin/abc\r\n" +
+		"\n" +
+		"SHA3-256 (abc 2.txt) = " + gen.Hex(gen.SHA3_256([]byte("abc"))) + "\n" +
+		"SHA1 (a (1).txt) = " + gen.Hex(gen.SHA1([]byte("abc"))) + "\n" +
+		`\` + abcSHA256 + "  back\\\\slash\\nnewline\n"
+
+	m, err := gen.ParseManifest(strings.NewReader(input), gen.Algorithm{})
+	if err != nil {
+		t.Fatalf("ParseManifest failed: %v", err)
+	}
+
+	expected := []struct {
+		path   string
+		alg    string
+		binary bool
+	}{
+		{"abc.txt", "sha256", false},
+		{"bin/abc", "sha256", true},
+		{"abc 2.txt", "sha3-256", false},
+		{"a (1).txt", "sha1", false},
+		{"back\\slash\nnewline", "sha256", false},
+	}
+	if len(m.Entries) != len(expected) {
+		t.Fatalf("expected %d entries, got %d", len(expected), len(m.Entries))
+	}
+	for i, e := range expected {
+		got := m.Entries[i]
+		if got.Path != e.path || got.Algorithm.Name != e.alg || got.Binary != e.binary {
+			t.Errorf("entry %d = %q %s %v, expected %q %s %v", i,
+				got.Path, got.Algorithm.Name, got.Binary, e.path, e.alg, e.binary)
+		}
+	}
+
+	// Escaped names must encode back to the same line
+	var out bytes.Buffer
+	(&gen.Manifest{Entries: m.Entries[4:]}).Encode(&out, gen.FormatGNU)
+	if out.String() != `\`+abcSHA256+"  back\\\\slash\\nnewline\n" {
+		t.Errorf("escaped round trip = %q", out.String())
+	}
+}
+
+func TestParseManifestInvalid(t *testing.T) {
+	sha512, _ := gen.ParseAlgorithm("sha512")
+	tests := []string{
+		"nothex  file\n",
+		abcSHA256 + "\n",
+		abcSHA256 + " file\n",
+		"MD5 (file) = 900150983cd24fb0d6963f7d28e17f72\n",
+		"SHA256 (file) = abcd\n",
+		"abcd  file\n",
+	}
+	for _, in := range tests {
+		if _, err := gen.ParseManifest(strings.NewReader(in), gen.Algorithm{}); err == nil {
+			t.Errorf("expected error for %q", in)
+		}
+	}
+	in := abcSHA256 + "  file\n"
+	if _, err := gen.ParseManifest(strings.NewReader(in), sha512); err == nil {
+		t.Errorf("expected size error for sha512 manifest")
+	}
+}
+
+func TestVerifyManifest(t *testing.T) {
+	root := writeTree(t, map[string]string{
+		"good.txt":    "abc",
+		"bad.txt":     "tampered",
+		"extra.txt":   "new",
+		"SHA256SUMS":  "",
+		"dir/ok.txt":  "abc",
+		"dir/new.txt": "new",
+	})
+	manifest := abcSHA256 + "  good.txt\n" +
+		abcSHA256 + "  bad.txt\n" +
+		abcSHA256 + "  gone.txt\n" +
+		"SHA256 (dir/ok.txt) = " + abcSHA256 + "\n"
+	os.WriteFile(filepath.Join(root, "SHA256SUMS"), []byte(manifest), 0o600)
+
+	m, err := gen.ReadManifest(filepath.Join(root, "SHA256SUMS"), gen.Algorithm{})
+	if err != nil {
+		t.Fatalf("ReadManifest failed: %v", err)
+	}
+	report, err := gen.VerifyManifest(context.Background(), m, root,
+		gen.WithExclude("SHA256SUMS"), gen.WithWorkers(3))
+	if err != nil {
+		t.Fatalf("VerifyManifest failed: %v", err)
+	}
+
+	if !reflect.DeepEqual(report.OK, []string{"good.txt", "dir/ok.txt"}) {
+		t.Errorf("OK = %v", report.OK)
+	}
+	if !reflect.DeepEqual(report.Mismatched, []string{"bad.txt"}) {
+		t.Errorf("Mismatched = %v", report.Mismatched)
+	}
+	if !reflect.DeepEqual(report.Missing, []string{"gone.txt"}) {
+		t.Errorf("Missing = %v", report.Missing)
+	}
+	if !reflect.DeepEqual(report.Extra, []string{"dir/new.txt", "extra.txt"}) {
+		t.Errorf("Extra = %v", report.Extra)
+	}
+	if len(report.Errors) != 0 {
+		t.Errorf("Errors = %v", report.Errors)
+	}
+	if report.Valid() {
+		t.Error("report should not be valid")
+	}
+}
+
+func TestVerifyManifestTraversal(t *testing.T) {
+	dir := writeTree(t, map[string]string{
+		"secret.txt":    "abc",
+		"root/file.txt": "abc",
+	})
+	root := filepath.Join(dir, "root")
+	outside := []string{
+		"../secret.txt",
+		"dir/../../secret.txt",
+		filepath.ToSlash(filepath.Join(dir, "secret.txt")),
+	}
+	sha256, err := gen.ParseAlgorithm("sha256")
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := &gen.Manifest{}
+	for _, p := range append([]string{"file.txt"}, outside...) {
+		m.Entries = append(m.Entries, gen.ManifestEntry{Path: p,
+			Sum: mustHex(t, abcSHA256), Algorithm: sha256})
+	}
+
+	report, err := gen.VerifyManifest(context.Background(), m, root)
+	if err != nil {
+		t.Fatalf("VerifyManifest failed: %v", err)
+	}
+	if !reflect.DeepEqual(report.OK, []string{"file.txt"}) {
+		t.Errorf("OK = %v", report.OK)
+	}
+	for _, p := range outside {
+		if err := report.Errors[p]; !errors.Is(err, gen.ErrInvalidManifest) {
+			t.Errorf("%s: err = %v, want ErrInvalidManifest", p, err)
+		}
+	}
+}
+
+func TestVerifyManifestSymlink(t *testing.T) {
+	dir := writeTree(t, map[string]string{
+		"secret.txt":    "abc",
+		"root/file.txt": "abc",
+	})
+	root := filepath.Join(dir, "root")
+	if err := os.Symlink(dir, filepath.Join(root, "link")); err != nil {
+		t.Skipf("symlinks not supported: %v", err)
+	}
+	sha256, err := gen.ParseAlgorithm("sha256")
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := &gen.Manifest{}
+	for _, p := range []string{"./file.txt", "link/secret.txt"} {
+		m.Entries = append(m.Entries, gen.ManifestEntry{Path: p,
+			Sum: mustHex(t, abcSHA256), Algorithm: sha256})
+	}
+
+	report, err := gen.VerifyManifest(context.Background(), m, root)
+	if err != nil {
+		t.Fatalf("VerifyManifest failed: %v", err)
+	}
+	if !reflect.DeepEqual(report.OK, []string{"./file.txt"}) {
+		t.Errorf("OK = %v", report.OK)
+	}
+	if report.Errors["link/secret.txt"] == nil {
+		t.Error("file behind a symlink out of root was hashed")
+	}
+	for _, p := range report.Extra {
+		if p == "file.txt" {
+			t.Errorf("listed ./file.txt reported as extra")
+		}
+	}
+}
+
+func TestManifestRoundTrip(t *testing.T) {
+	files := map[string]string{}
+	for i := 0; i < 50; i++ {
+		files[filepath.ToSlash(filepath.Join("d", string(rune('a'+i%5)), strings.Repeat("x", i+1)))] =
+			strings.Repeat("data", i)
+	}
+	root := writeTree(t, files)
+
+	for _, name := range []string{"sha1", "sha384", "sha3-512", "shake256/32"} {
+		for _, format := range []gen.ManifestFormat{gen.FormatGNU, gen.FormatBSD} {
+			alg, _ := gen.ParseAlgorithm(name)
+			m, err := gen.CreateManifest(context.Background(), root, alg)
+			if err != nil {
+				t.Fatalf("%s: CreateManifest failed: %v", name, err)
+			}
+			var buf bytes.Buffer
+			m.Encode(&buf, format)
+			back, err := gen.ParseManifest(&buf, alg)
+			if err != nil {
+				t.Fatalf("%s: ParseManifest failed: %v", name, err)
+			}
+			report, err := gen.VerifyManifest(context.Background(), back, root)
+			if err != nil {
+				t.Fatalf("%s: VerifyManifest failed: %v", name, err)
+			}
+			if !report.Valid() || len(report.OK) != len(files) || len(report.Extra) != 0 {
+				t.Errorf("%s/%d: report OK=%d Missing=%v Mismatched=%v Extra=%v",
+					name, format, len(report.OK), report.Missing,
+					report.Mismatched, report.Extra)
+			}
+		}
+	}
+}
+
+func TestManifestCancel(t *testing.T) {
+	root := writeTree(t, map[string]string{"a": "a", "b": "b"})
+	alg, _ := gen.ParseAlgorithm("sha256")
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := gen.CreateManifest(ctx, root, alg); err == nil {
+		t.Error("expected error for cancelled context")
+	}
+}
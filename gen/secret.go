@@ -0,0 +1,313 @@
+// secret.go - Part of the `gen` Package
+//
+//     ॐ भूर्भुवः स्वः
+//     तत्स॑वि॒तुर्वरे॑ण्यं॒
+//    भर्गो॑ दे॒वस्य॑ धीमहि।
+//   धियो॒ यो नः॑ प्रचो॒दया॑त्॥
+//
+//
+//  बी.स.जी - बोसजी के द्वारा रचित सुरक्षा एवं गोपनीयता हेतु तन्त्राक्ष्।
+// ================================================
+//
+// एक सुरक्षा एवं गोपनीयता केंद्रित तंत्राक्षों का संकलन।
+//
+// एक रचनात्मक भारतीय उत्पाद ।
+//
+// bsg - Boseji's Security and Privacy Utilities
+//
+// A collection of Security and Privacy utilities and some notes for help.
+//
+// This is **Golang** package collection as well as few utility
+// command line programs.
+//
+// Sources
+// -------
+// https://github.com/boseji/bsg
+//
+// License
+// -------
+//
+//   bsg - Boseji's Security and Privacy Utilities.
+//   Copyright (C) 2025-2026 by Abhijit Bose (aka. Boseji)
+//
+//   This program is free software: you can redistribute it and/or modify
+//   it under the terms of the GNU General Public License version 2 only
+//   as published by the Free Software Foundation.
+//
+//   This program is distributed in the hope that it will be useful,
+//   but WITHOUT ANY WARRANTY; without even the implied warranty of
+//   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+//
+//   You should have received a copy of the GNU General Public License
+//   along with this program. If not, see <https://www.gnu.org/licenses/>.
+//
+//  SPDX-License-Identifier: GPL-2.0-only
+//  Full Name: GNU General Public License v2.0 only
+//  Please visit <https://spdx.org/licenses/GPL-2.0-only.html> for details.
+//
+
+package gen
+
+import (
+	"bytes"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"log/slog"
+	"runtime"
+	"sync"
+	"unsafe"
+)
+
+// ErrSecretDestroyed is returned by Use after Destroy.
+var ErrSecretDestroyed = errors.New("secret destroyed")
+
+// redacted replaces the content of a Secret wherever it is printed.
+const redacted = "[REDACTED]"
+
+// Secret holds sensitive bytes such as a password or key.
+//
+// Unlike a string, a Secret can be wiped: Destroy overwrites the bytes
+// with zeros. Where the OS allows it the memory is also locked so that
+// it is not written to swap (mlock on Linux). Printing a Secret with
+// fmt, slog or encoding/json shows only "[REDACTED]".
+//
+// A Secret must not be copied after first use.
+type Secret struct {
+	mu  sync.Mutex
+	mem *secretMem
+	b   []byte // the secret, a prefix of mem.b
+}
+
+// secretMem is the memory behind a Secret. It is a separate object so
+// that it is released when an unreachable Secret is collected, even
+// when the Secret is embedded in another value.
+type secretMem struct {
+	b      []byte
+	mapped bool
+	locked bool
+}
+
+// newSecretMem allocates n bytes, locking them where possible.
+func newSecretMem(n int) *secretMem {
+	m := &secretMem{}
+	m.b, m.mapped, m.locked = allocSecret(n)
+	runtime.SetFinalizer(m, (*secretMem).free)
+	return m
+}
+
+// free zeroes and releases the memory.
+func (m *secretMem) free() {
+	clear(m.b)
+	freeSecret(m.b, m.mapped, m.locked)
+	m.b = nil
+	runtime.SetFinalizer(m, nil)
+}
+
+// NewSecret copies b into a new Secret and then zeroes b.
+func NewSecret(b []byte) *Secret {
+	s := newSecret(len(b))
+	copy(s.b, b)
+	clear(b)
+	return s
+}
+
+// NewSecretString copies str into a new Secret. The string itself
+// cannot be wiped, so prefer NewSecret where the bytes are available.
+func NewSecretString(str string) *Secret {
+	s := newSecret(len(str))
+	copy(s.b, str)
+	return s
+}
+
+// RandomSecret returns a Secret holding n secure random bytes.
+func RandomSecret(n int) (*Secret, error) {
+	if n < 0 {
+		return nil, fmt.Errorf("RandomSecret: %w: n must be >= 0", ErrInvalidArgument)
+	}
+	s := newSecret(n)
+	if _, err := defaultGenerator.Read(s.b); err != nil {
+		s.Destroy()
+		return nil, err
+	}
+	return s, nil
+}
+
+// newSecret allocates a zeroed Secret of n bytes.
+func newSecret(n int) *Secret {
+	m := newSecretMem(n)
+	return &Secret{mem: m, b: m.b[:n]}
+}
+
+// Use calls fn with the secret bytes themselves, not a copy. The
+// memory stays valid and Destroy waits until fn returns, so fn may read
+// or modify the bytes, but must not keep the slice or call methods of
+// the Secret. After Destroy, Use returns ErrSecretDestroyed without
+// calling fn; otherwise it returns the error of fn.
+func (s *Secret) Use(fn func(b []byte) error) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.mem == nil {
+		return ErrSecretDestroyed
+	}
+	return fn(s.b)
+}
+
+// use calls fn with the secret bytes, nil after Destroy, holding the
+// lock as Use does.
+func (s *Secret) use(fn func(b []byte)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	fn(s.b)
+}
+
+// Len returns the length of the secret, 0 after Destroy.
+func (s *Secret) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.b)
+}
+
+// Locked reports whether the memory is locked against swapping.
+func (s *Secret) Locked() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.mem != nil && s.mem.locked
+}
+
+// Equal reports whether both secrets hold the same bytes, in constant
+// time for secrets of equal length.
+func (s *Secret) Equal(o *Secret) bool {
+	if s == o {
+		return true
+	}
+	// Lock in address order, so that a.Equal(b) and b.Equal(a) running
+	// together cannot deadlock.
+	first, second := s, o
+	if uintptr(unsafe.Pointer(o)) < uintptr(unsafe.Pointer(s)) {
+		first, second = o, s
+	}
+	first.mu.Lock()
+	defer first.mu.Unlock()
+	second.mu.Lock()
+	defer second.mu.Unlock()
+	return subtle.ConstantTimeCompare(s.b, o.b) == 1
+}
+
+// Destroy zeroes the secret and releases its memory. It is safe to call
+// more than once; the memory of a Secret that is garbage collected is
+// zeroed and released too.
+func (s *Secret) Destroy() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.mem != nil {
+		s.mem.free()
+	}
+	s.mem, s.b = nil, nil
+}
+
+// String implements fmt.Stringer without revealing the secret.
+func (s *Secret) String() string {
+	return redacted
+}
+
+// GoString implements fmt.GoStringer without revealing the secret.
+func (s *Secret) GoString() string {
+	return "gen.Secret{" + redacted + "}"
+}
+
+// Format prints "[REDACTED]" for every verb, including %x and %v.
+func (s *Secret) Format(f fmt.State, verb rune) {
+	if verb == 'v' && f.Flag('#') {
+		fmt.Fprint(f, s.GoString())
+		return
+	}
+	fmt.Fprint(f, redacted)
+}
+
+// LogValue implements slog.LogValuer without revealing the secret.
+func (s *Secret) LogValue() slog.Value {
+	return slog.StringValue(redacted)
+}
+
+// MarshalJSON always encodes the secret as "[REDACTED]".
+func (s *Secret) MarshalJSON() ([]byte, error) {
+	return json.Marshal(redacted)
+}
+
+// MarshalText always encodes the secret as "[REDACTED]".
+func (s *Secret) MarshalText() ([]byte, error) {
+	return []byte(redacted), nil
+}
+
+// UnmarshalJSON reads a JSON string into the Secret. Strings without
+// escapes are copied straight from the input, so no extra copy is left
+// behind in an immutable string.
+func (s *Secret) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if len(data) < 2 || data[0] != '"' || data[len(data)-1] != '"' {
+		return fmt.Errorf("gen: Secret must be a JSON string")
+	}
+	var value []byte
+	if raw := data[1 : len(data)-1]; bytes.IndexByte(raw, '\\') < 0 {
+		value = raw
+	} else {
+		var str string
+		if err := json.Unmarshal(data, &str); err != nil {
+			return err
+		}
+		value = []byte(str)
+		defer clear(value)
+	}
+	m := newSecretMem(len(value))
+	copy(m.b, value)
+	s.Destroy()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.mem, s.b = m, m.b[:len(value)]
+	return nil
+}
+
+// DecodeBase32Secret decodes base32 text held in a Secret, such as a
+// TOTP key, into a new Secret, leniently as DecodeBase32 does. No copy
+// of the text or the key is left outside the two secrets.
+func DecodeBase32Secret(s *Secret) (*Secret, error) {
+	var norm *Secret
+	n := 0
+	s.use(func(src []byte) {
+		norm = newSecret(len(src))
+		for _, c := range src {
+			switch c {
+			case ' ', '\t', '\r', '\n', '=':
+				continue
+			}
+			norm.b[n] = upperASCII(c)
+			n++
+		}
+	})
+	defer norm.Destroy()
+	out := newSecret(base32NoPad.DecodedLen(n))
+	m, err := base32NoPad.Decode(out.b, norm.b[:n])
+	if err != nil {
+		out.Destroy()
+		return nil, fmt.Errorf("%w: %v", ErrInvalidEncoding, err)
+	}
+	out.b = out.b[:m]
+	return out, nil
+}
+
+// SumSecret hashes the secret with the algorithm.
+func (a Algorithm) SumSecret(s *Secret) []byte {
+	var sum []byte
+	s.use(func(b []byte) { sum = a.Sum(b) })
+	return sum
+}
+
+// HMACSecret computes the HMAC of data under a key held in a Secret.
+func HMACSecret(h func() hash.Hash, key *Secret, data []byte) []byte {
+	var mac []byte
+	key.use(func(b []byte) { mac = HMAC(h, b, data) })
+	return mac
+}
@@ -0,0 +1,81 @@
+//go:build linux
+
+// secret_linux.go - Part of the `gen` Package
+//
+//     ॐ भूर्भुवः स्वः
+//     तत्स॑वि॒तुर्वरे॑ण्यं॒
+//    भर्गो॑ दे॒वस्य॑ धीमहि।
+//   धियो॒ यो नः॑ प्रचो॒दया॑त्॥
+//
+//
+//  बी.स.जी - बोसजी के द्वारा रचित सुरक्षा एवं गोपनीयता हेतु तन्त्राक्ष्।
+// ================================================
+//
+// एक सुरक्षा एवं गोपनीयता केंद्रित तंत्राक्षों का संकलन।
+//
+// एक रचनात्मक भारतीय उत्पाद ।
+//
+// bsg - Boseji's Security and Privacy Utilities
+//
+// A collection of Security and Privacy utilities and some notes for help.
+//
+// This is **Golang** package collection as well as few utility
+// command line programs.
+//
+// Sources
+// -------
+// https://github.com/boseji/bsg
+//
+// License
+// -------
+//
+//   bsg - Boseji's Security and Privacy Utilities.
+//   Copyright (C) 2025-2026 by Abhijit Bose (aka. Boseji)
+//
+//   This program is free software: you can redistribute it and/or modify
+//   it under the terms of the GNU General Public License version 2 only
+//   as published by the Free Software Foundation.
+//
+//   This program is distributed in the hope that it will be useful,
+//   but WITHOUT ANY WARRANTY; without even the implied warranty of
+//   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+//
+//   You should have received a copy of the GNU General Public License
+//   along with this program. If not, see <https://www.gnu.org/licenses/>.
+//
+//  SPDX-License-Identifier: GPL-2.0-only
+//  Full Name: GNU General Public License v2.0 only
+//  Please visit <https://spdx.org/licenses/GPL-2.0-only.html> for details.
+//
+
+package gen
+
+import "syscall"
+
+// allocSecret maps n bytes outside the Go heap and locks them in RAM.
+// If mapping fails it falls back to the heap; if locking fails, for
+// instance because of RLIMIT_MEMLOCK, the memory is used unlocked.
+func allocSecret(n int) (mem []byte, mapped, locked bool) {
+	if n == 0 {
+		return []byte{}, false, false
+	}
+	mem, err := syscall.Mmap(-1, 0, n, syscall.PROT_READ|syscall.PROT_WRITE,
+		syscall.MAP_ANON|syscall.MAP_PRIVATE)
+	if err != nil {
+		return make([]byte, n), false, false
+	}
+	// Keep the secret out of child processes.
+	_ = syscall.Madvise(mem, syscall.MADV_DONTFORK)
+	return mem, true, syscall.Mlock(mem) == nil
+}
+
+// freeSecret unlocks and unmaps memory from allocSecret. The caller
+// has already zeroed it.
+func freeSecret(mem []byte, mapped, locked bool) {
+	if locked {
+		_ = syscall.Munlock(mem)
+	}
+	if mapped {
+		_ = syscall.Munmap(mem)
+	}
+}
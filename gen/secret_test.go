@@ -0,0 +1,270 @@
+// secret_test.go - Test Program `gen` Package
+//
+//     ॐ भूर्भुवः स्वः
+//     तत्स॑वि॒तुर्वरे॑ण्यं॒
+//    भर्गो॑ दे॒वस्य॑ धीमहि।
+//   धियो॒ यो नः॑ प्रचो॒दया॑त्॥
+//
+//
+//  बी.स.जी - बोसजी के द्वारा रचित सुरक्षा एवं गोपनीयता हेतु तन्त्राक्ष्।
+// ================================================
+//
+// एक सुरक्षा एवं गोपनीयता केंद्रित तंत्राक्षों का संकलन।
+//
+// एक रचनात्मक भारतीय उत्पाद ।
+//
+// bsg - Boseji's Security and Privacy Utilities
+//
+// A collection of Security and Privacy utilities and some notes for help.
+//
+// This is **Golang** package collection as well as few utility
+// command line programs.
+//
+// Sources
+// -------
+// https://github.com/boseji/bsg
+//
+// License
+// -------
+//
+//   bsg - Boseji's Security and Privacy Utilities.
+//   Copyright (C) 2025-2026 by Abhijit Bose (aka. Boseji)
+//
+//   This program is free software: you can redistribute it and/or modify
+//   it under the terms of the GNU General Public License version 2 only
+//   as published by the Free Software Foundation.
+//
+//   This program is distributed in the hope that it will be useful,
+//   but WITHOUT ANY WARRANTY; without even the implied warranty of
+//   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+//
+//   You should have received a copy of the GNU General Public License
+//   along with this program. If not, see <https://www.gnu.org/licenses/>.
+//
+//  SPDX-License-Identifier: GPL-2.0-only
+//  Full Name: GNU General Public License v2.0 only
+//  Please visit <https://spdx.org/licenses/GPL-2.0-only.html> for details.
+//
+
+package gen_test
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"log/slog"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/boseji/bsg/gen"
+)
+
+func TestSecretRedaction(t *testing.T) {
+	s := gen.NewSecretString("hunter2")
+	defer s.Destroy()
+	outputs := []string{
+		fmt.Sprint(s), fmt.Sprintf("%v %s %q %x %X %d", s, s, s, s, s, s),
+		fmt.Sprintf("%+v %#v", s, s), fmt.Sprintf("%v", struct{ P *gen.Secret }{s}),
+	}
+	j, err := json.Marshal(struct{ Password *gen.Secret }{s})
+	if err != nil {
+		t.Fatal(err)
+	}
+	outputs = append(outputs, string(j))
+	var logs bytes.Buffer
+	slog.New(slog.NewTextHandler(&logs, nil)).Info("login", "password", s)
+	outputs = append(outputs, logs.String())
+
+	for _, out := range outputs {
+		if strings.Contains(out, "hunter2") || strings.Contains(out, "68756e74657232") {
+			t.Errorf("secret leaked in %q", out)
+		}
+		if !strings.Contains(out, "[REDACTED]") {
+			t.Errorf("no redaction marker in %q", out)
+		}
+	}
+	if got := reveal(t, s); got != "hunter2" {
+		t.Fatalf("Use = %q", got)
+	}
+}
+
+// reveal copies the content of a Secret out through Use.
+func reveal(t *testing.T, s *gen.Secret) string {
+	t.Helper()
+	var out string
+	if err := s.Use(func(b []byte) error {
+		out = string(b)
+		return nil
+	}); err != nil {
+		t.Fatalf("Use: %v", err)
+	}
+	return out
+}
+
+func TestSecretDestroy(t *testing.T) {
+	src := []byte("top secret")
+	s := gen.NewSecret(src)
+	if !bytes.Equal(src, make([]byte, len(src))) {
+		t.Fatalf("NewSecret left the source intact: %q", src)
+	}
+	// Keeping the slice breaks the rule of Use, on purpose, to look at
+	// the memory after Destroy.
+	var b []byte
+	s.Use(func(p []byte) error {
+		b = p
+		return nil
+	})
+	if string(b) != "top secret" || s.Len() != 10 {
+		t.Fatalf("Use = %q", b)
+	}
+	// Zero before release, observed on heap backed memory only.
+	if !s.Locked() {
+		s.Destroy()
+		if !bytes.Equal(b, make([]byte, len(b))) {
+			t.Fatalf("Destroy left %q", b)
+		}
+	}
+	s.Destroy()
+	s.Destroy()
+	called := false
+	err := s.Use(func([]byte) error {
+		called = true
+		return nil
+	})
+	if !errors.Is(err, gen.ErrSecretDestroyed) || called || s.Len() != 0 || s.Locked() {
+		t.Fatal("destroyed secret still usable")
+	}
+
+	r, err := gen.RandomSecret(32)
+	if err != nil || r.Len() != 32 {
+		t.Fatalf("RandomSecret: %v", err)
+	}
+	if r.Equal(gen.NewSecretString("x")) || !r.Equal(r) ||
+		!gen.NewSecretString("ab").Equal(gen.NewSecretString("ab")) {
+		t.Fatal("Equal")
+	}
+	var zero gen.Secret
+	if zero.Len() != 0 || zero.String() != "[REDACTED]" {
+		t.Fatal("zero Secret")
+	}
+	zero.Destroy()
+}
+
+func TestSecretUnmarshalJSON(t *testing.T) {
+	var v struct {
+		Name   string
+		Plain  *gen.Secret
+		Escape *gen.Secret
+		Value  gen.Secret
+	}
+	in := `{"Name":"a","Plain":"JBSWY3DPEHPK3PXP","Escape":"pass\"word","Value":"v"}`
+	if err := json.Unmarshal([]byte(in), &v); err != nil {
+		t.Fatal(err)
+	}
+	plain, escape, value := reveal(t, v.Plain), reveal(t, v.Escape), reveal(t, &v.Value)
+	if plain != "JBSWY3DPEHPK3PXP" || escape != `pass"word` || value != "v" {
+		t.Fatalf("got %q %q %q", plain, escape, value)
+	}
+	if err := json.Unmarshal([]byte(`{"Plain":42}`), &v); err == nil {
+		t.Fatal("number accepted as secret")
+	}
+}
+
+func TestSecretOverloads(t *testing.T) {
+	key := gen.NewSecretString("jbsw y3dp ehpk 3pxp")
+	dec, err := gen.DecodeBase32Secret(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, _ := gen.DecodeBase32("JBSWY3DPEHPK3PXP")
+	if got := reveal(t, dec); got != string(want) {
+		t.Fatalf("DecodeBase32Secret = %x, want %x", got, want)
+	}
+	if _, err := gen.DecodeBase32Secret(gen.NewSecretString("!!")); !errors.Is(err, gen.ErrInvalidEncoding) {
+		t.Fatalf("bad base32: %v", err)
+	}
+
+	if !bytes.Equal(gen.HMACSecret(sha256.New, dec, []byte("m")), gen.HMACSHA256(want, []byte("m"))) {
+		t.Fatal("HMACSecret differs from HMAC")
+	}
+	alg, _ := gen.ParseAlgorithm("sha256")
+	if !bytes.Equal(alg.SumSecret(dec), gen.SHA256(want)) {
+		t.Fatal("SumSecret differs from Sum")
+	}
+
+	pw := gen.NewSecretString("correct horse")
+	h, err := gen.BcryptHashC("correct horse", gen.MinBcryptCost)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := gen.BcryptVerifySecret(pw, h); err != nil {
+		t.Fatalf("BcryptVerifySecret: %v", err)
+	}
+	if err := gen.BcryptVerifySecret(gen.NewSecretString("wrong"), h); !errors.Is(err, gen.ErrBcryptMismatch) {
+		t.Fatalf("wrong password: %v", err)
+	}
+	hs, err := gen.BcryptHashSecret(pw)
+	if err != nil || !gen.BcryptCheck("correct horse", hs) {
+		t.Fatalf("BcryptHashSecret: %v", err)
+	}
+	long := gen.NewSecretString(strings.Repeat("p", 100))
+	hp, err := gen.BcryptHashPreSecret(long)
+	if err != nil || gen.BcryptVerifySecret(long, hp) != nil {
+		t.Fatalf("BcryptHashPreSecret: %v", err)
+	}
+}
+
+// TestSecretKeepAlive collects garbage while HMACSecret is reading the
+// key, so an unreachable Secret would be unmapped under it.
+func TestSecretKeepAlive(t *testing.T) {
+	collect := func() hash.Hash {
+		runtime.GC()
+		runtime.GC()
+		return sha256.New()
+	}
+	data := []byte("message")
+	want := gen.HMAC(sha256.New, []byte("key"), data)
+
+	for range 20 {
+		got := gen.HMACSecret(collect, gen.NewSecretString("key"), data)
+		if !bytes.Equal(got, want) {
+			t.Fatalf("HMACSecret = %x, want %x", got, want)
+		}
+	}
+}
+
+// TestSecretUseDestroy calls Destroy while Use is running, which must
+// wait for the callback so the bytes stay valid under it.
+func TestSecretUseDestroy(t *testing.T) {
+	s := gen.NewSecretString("key")
+	inside := make(chan struct{})
+	done := make(chan struct{})
+	var got string
+	go func() {
+		<-inside
+		s.Destroy()
+		close(done)
+	}()
+	err := s.Use(func(b []byte) error {
+		close(inside)
+		select {
+		case <-done:
+			t.Error("Destroy returned during Use")
+		case <-time.After(50 * time.Millisecond):
+		}
+		got = string(b)
+		return nil
+	})
+	<-done
+	if err != nil || got != "key" {
+		t.Fatalf("Use = %q, %v", got, err)
+	}
+	if err := s.Use(func([]byte) error { return nil }); !errors.Is(err, gen.ErrSecretDestroyed) {
+		t.Fatalf("Use after Destroy: %v", err)
+	}
+}
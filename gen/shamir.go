@@ -0,0 +1,298 @@
+// shamir.go - Part of the `gen` Package
+//
+//     ॐ भूर्भुवः स्वः
+//     तत्स॑वि॒तुर्वरे॑ण्यं॒
+//    भर्गो॑ दे॒वस्य॑ धीमहि।
+//   धियो॒ यो नः॑ प्रचो॒दया॑त्॥
+//
+//
+//  बी.स.जी - बोसजी के द्वारा रचित सुरक्षा एवं गोपनीयता हेतु तन्त्राक्ष्।
+// ================================================
+//
+// एक सुरक्षा एवं गोपनीयता केंद्रित तंत्राक्षों का संकलन।
+//
+// एक रचनात्मक भारतीय उत्पाद ।
+//
+// bsg - Boseji's Security and Privacy Utilities
+//
+// A collection of Security and Privacy utilities and some notes for help.
+//
+// This is **Golang** package collection as well as few utility
+// command line programs.
+//
+// Sources
+// -------
+// https://github.com/boseji/bsg
+//
+// License
+// -------
+//
+//   bsg - Boseji's Security and Privacy Utilities.
+//   Copyright (C) 2025-2026 by Abhijit Bose (aka. Boseji)
+//
+//   This program is free software: you can redistribute it and/or modify
+//   it under the terms of the GNU General Public License version 2 only
+//   as published by the Free Software Foundation.
+//
+//   This program is distributed in the hope that it will be useful,
+//   but WITHOUT ANY WARRANTY; without even the implied warranty of
+//   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+//
+//   You should have received a copy of the GNU General Public License
+//   along with this program. If not, see <https://www.gnu.org/licenses/>.
+//
+//  SPDX-License-Identifier: GPL-2.0-only
+//  Full Name: GNU General Public License v2.0 only
+//  Please visit <https://spdx.org/licenses/GPL-2.0-only.html> for details.
+//
+
+package gen
+
+import (
+	"crypto/sha256"
+	hx "encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ShareSetIDSize is the length of the identifier shared by all shares
+// from one split.
+const ShareSetIDSize = 4
+
+// sharePrefix starts the text form of every share.
+const sharePrefix = "bsgss1"
+
+var (
+	ErrInvalidShare   = errors.New("shamir: invalid share")
+	ErrShareMismatch  = errors.New("shamir: shares come from different splits")
+	ErrDuplicateShare = errors.New("shamir: duplicate share")
+	ErrTooFewShares   = errors.New("shamir: not enough shares")
+)
+
+// Share is one part of a secret split by ShamirSplit.
+type Share struct {
+	SetID     [ShareSetIDSize]byte // Identifies the split the share belongs to.
+	Threshold int                  // Shares needed to recover the secret.
+	Index     byte                 // X coordinate of the share, from 1.
+	Data      []byte               // Y coordinates, one per secret byte.
+}
+
+// String returns the text form of the share:
+//
+//	bsgss1-<set id>-<threshold>-<index>-<base58 data and checksum>
+//
+// The checksum is the first 4 bytes of SHA-256 over the header and data,
+// so typing mistakes are caught before recombining.
+func (s Share) String() string {
+	head := s.header()
+	return head + Base58(append(append([]byte{}, s.Data...), shareChecksum(head, s.Data)...))
+}
+
+// header returns the text form up to and including the last '-'.
+func (s Share) header() string {
+	return fmt.Sprintf("%s-%s-%d-%d-", sharePrefix, Hex(s.SetID[:]), s.Threshold, s.Index)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s Share) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *Share) UnmarshalText(b []byte) error {
+	v, err := ParseShare(string(b))
+	if err != nil {
+		return err
+	}
+	*s = v
+	return nil
+}
+
+// ParseShare parses the text form of a share and checks its checksum.
+// Surrounding white space is ignored.
+func ParseShare(text string) (Share, error) {
+	var s Share
+	text = strings.TrimSpace(text)
+	parts := strings.Split(text, "-")
+	if len(parts) != 5 || parts[0] != sharePrefix {
+		return s, fmt.Errorf("%w: bad format", ErrInvalidShare)
+	}
+	id, err := hx.DecodeString(parts[1])
+	if err != nil || len(id) != ShareSetIDSize {
+		return s, fmt.Errorf("%w: bad set id %q", ErrInvalidShare, parts[1])
+	}
+	copy(s.SetID[:], id)
+	k, err := strconv.Atoi(parts[2])
+	if err != nil || k < 2 || k > 255 {
+		return s, fmt.Errorf("%w: bad threshold %q", ErrInvalidShare, parts[2])
+	}
+	s.Threshold = k
+	x, err := strconv.Atoi(parts[3])
+	if err != nil || x < 1 || x > 255 {
+		return s, fmt.Errorf("%w: bad index %q", ErrInvalidShare, parts[3])
+	}
+	s.Index = byte(x)
+	b, err := DecodeBase58(parts[4])
+	if err != nil || len(b) < 5 {
+		return s, fmt.Errorf("%w: bad data", ErrInvalidShare)
+	}
+	data, sum := b[:len(b)-4], b[len(b)-4:]
+	if !Equal(sum, shareChecksum(s.header(), data)) {
+		return s, fmt.Errorf("%w: checksum mismatch", ErrInvalidShare)
+	}
+	s.Data = data
+	return s, nil
+}
+
+// shareChecksum returns the first 4 bytes of SHA-256(header || data).
+func shareChecksum(header string, data []byte) []byte {
+	h := sha256.New()
+	h.Write([]byte(header))
+	h.Write(data)
+	return h.Sum(nil)[:4]
+}
+
+// ShamirSplit splits secret into n shares, any k of which recover it.
+// Fewer than k shares reveal nothing about the secret. It needs
+// 2 <= k <= n <= 255.
+func ShamirSplit(secret []byte, n, k int) ([]Share, error) {
+	return ShamirSplitFrom(defaultGenerator, secret, n, k)
+}
+
+// ShamirSplitFrom is ShamirSplit drawing the set id and polynomial
+// coefficients from the generator g.
+func ShamirSplitFrom(g *Generator, secret []byte, n, k int) ([]Share, error) {
+	switch {
+	case len(secret) == 0:
+		return nil, fmt.Errorf("ShamirSplit: %w: empty secret", ErrInvalidArgument)
+	case k < 2:
+		return nil, fmt.Errorf("ShamirSplit: %w: threshold %d < 2", ErrInvalidArgument, k)
+	case n < k:
+		return nil, fmt.Errorf("ShamirSplit: %w: %d shares < threshold %d",
+			ErrInvalidArgument, n, k)
+	case n > 255:
+		return nil, fmt.Errorf("ShamirSplit: %w: %d shares > 255", ErrInvalidArgument, n)
+	}
+
+	var id [ShareSetIDSize]byte
+	if _, err := g.Read(id[:]); err != nil {
+		return nil, err
+	}
+	shares := make([]Share, n)
+	for i := range shares {
+		shares[i] = Share{SetID: id, Threshold: k, Index: byte(i + 1),
+			Data: make([]byte, len(secret))}
+	}
+
+	// One polynomial per secret byte, with the byte as constant term.
+	coeffs := make([]byte, k)
+	defer clear(coeffs)
+	for pos, b := range secret {
+		coeffs[0] = b
+		if _, err := g.Read(coeffs[1:]); err != nil {
+			return nil, err
+		}
+		for i := range shares {
+			shares[i].Data[pos] = gfEval(coeffs, shares[i].Index)
+		}
+	}
+	return shares, nil
+}
+
+// ShamirCombine recovers the secret from at least Threshold shares of
+// the same split. Shares beyond the threshold are checked for
+// consistency of their set but otherwise ignored.
+func ShamirCombine(shares []Share) ([]byte, error) {
+	if len(shares) == 0 {
+		return nil, ErrTooFewShares
+	}
+	first := shares[0]
+	seen := make(map[byte]bool, len(shares))
+	for _, s := range shares {
+		if s.SetID != first.SetID || s.Threshold != first.Threshold ||
+			len(s.Data) != len(first.Data) {
+			return nil, ErrShareMismatch
+		}
+		if s.Index == 0 {
+			return nil, fmt.Errorf("%w: index 0", ErrInvalidShare)
+		}
+		if seen[s.Index] {
+			return nil, fmt.Errorf("%w: index %d", ErrDuplicateShare, s.Index)
+		}
+		seen[s.Index] = true
+	}
+	k := first.Threshold
+	if len(shares) < k {
+		return nil, fmt.Errorf("%w: have %d, need %d", ErrTooFewShares, len(shares), k)
+	}
+	shares = shares[:k]
+
+	// Lagrange basis at x = 0; subtraction is XOR in GF(256).
+	basis := make([]byte, k)
+	for i, si := range shares {
+		num, den := byte(1), byte(1)
+		for j, sj := range shares {
+			if i != j {
+				num = gfMul(num, sj.Index)
+				den = gfMul(den, sj.Index^si.Index)
+			}
+		}
+		basis[i] = gfMul(num, gfInv(den))
+	}
+
+	secret := make([]byte, len(first.Data))
+	for pos := range secret {
+		var v byte
+		for i, s := range shares {
+			v ^= gfMul(s.Data[pos], basis[i])
+		}
+		secret[pos] = v
+	}
+	return secret, nil
+}
+
+// ShamirCombineText parses the text form of each share and recombines
+// them.
+func ShamirCombineText(texts []string) ([]byte, error) {
+	shares := make([]Share, 0, len(texts))
+	for _, t := range texts {
+		s, err := ParseShare(t)
+		if err != nil {
+			return nil, err
+		}
+		shares = append(shares, s)
+	}
+	return ShamirCombine(shares)
+}
+
+// gfMul multiplies in GF(256) with the AES polynomial x^8+x^4+x^3+x+1.
+// It avoids tables and branches on secret data.
+func gfMul(a, b byte) byte {
+	var p byte
+	for i := 0; i < 8; i++ {
+		p ^= a & -(b & 1)
+		a = a<<1 ^ 0x1b&-(a>>7)
+		b >>= 1
+	}
+	return p
+}
+
+// gfInv returns the multiplicative inverse a^254; gfInv(0) is 0.
+func gfInv(a byte) byte {
+	r := a
+	for i := 0; i < 6; i++ {
+		r = gfMul(gfMul(r, r), a)
+	}
+	return gfMul(r, r)
+}
+
+// gfEval evaluates the polynomial with the given coefficients at x.
+func gfEval(coeffs []byte, x byte) byte {
+	var y byte
+	for i := len(coeffs) - 1; i >= 0; i-- {
+		y = gfMul(y, x) ^ coeffs[i]
+	}
+	return y
+}
@@ -0,0 +1,179 @@
+// shamir_test.go - Test Program `gen` Package
+//
+//     ॐ भूर्भुवः स्वः
+//     तत्स॑वि॒तुर्वरे॑ण्यं॒
+//    भर्गो॑ दे॒वस्य॑ धीमहि।
+//   धियो॒ यो नः॑ प्रचो॒दया॑त्॥
+//
+//
+//  बी.स.जी - बोसजी के द्वारा रचित सुरक्षा एवं गोपनीयता हेतु तन्त्राक्ष्।
+// ================================================
+//
+// एक सुरक्षा एवं गोपनीयता केंद्रित तंत्राक्षों का संकलन।
+//
+// एक रचनात्मक भारतीय उत्पाद ।
+//
+// bsg - Boseji's Security and Privacy Utilities
+//
+// A collection of Security and Privacy utilities and some notes for help.
+//
+// This is **Golang** package collection as well as few utility
+// command line programs.
+//
+// Sources
+// -------
+// https://github.com/boseji/bsg
+//
+// License
+// -------
+//
+//   bsg - Boseji's Security and Privacy Utilities.
+//   Copyright (C) 2025-2026 by Abhijit Bose (aka. Boseji)
+//
+//   This program is free software: you can redistribute it and/or modify
+//   it under the terms of the GNU General Public License version 2 only
+//   as published by the Free Software Foundation.
+//
+//   This program is distributed in the hope that it will be useful,
+//   but WITHOUT ANY WARRANTY; without even the implied warranty of
+//   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+//
+//   You should have received a copy of the GNU General Public License
+//   along with this program. If not, see <https://www.gnu.org/licenses/>.
+//
+//  SPDX-License-Identifier: GPL-2.0-only
+//  Full Name: GNU General Public License v2.0 only
+//  Please visit <https://spdx.org/licenses/GPL-2.0-only.html> for details.
+//
+
+package gen_test
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/boseji/bsg/gen"
+)
+
+func TestShamirRoundTrip(t *testing.T) {
+	secret := []byte("correct horse battery staple")
+	tests := []struct{ n, k int }{{2, 2}, {3, 2}, {5, 3}, {7, 7}, {255, 4}}
+	for _, tt := range tests {
+		shares, err := gen.ShamirSplit(secret, tt.n, tt.k)
+		if err != nil {
+			t.Fatalf("ShamirSplit(%d, %d): %v", tt.n, tt.k, err)
+		}
+		if len(shares) != tt.n {
+			t.Fatalf("got %d shares, want %d", len(shares), tt.n)
+		}
+		// Every window of k consecutive shares recovers the secret.
+		for i := 0; i+tt.k <= tt.n; i++ {
+			got, err := gen.ShamirCombine(shares[i : i+tt.k])
+			if err != nil || !bytes.Equal(got, secret) {
+				t.Fatalf("n=%d k=%d from %d: %q, %v", tt.n, tt.k, i, got, err)
+			}
+		}
+		// Fewer shares are refused.
+		_, err = gen.ShamirCombine(shares[:tt.k-1])
+		if !errors.Is(err, gen.ErrTooFewShares) {
+			t.Fatalf("k-1 shares: %v", err)
+		}
+	}
+}
+
+func TestShamirText(t *testing.T) {
+	secret := []byte{0, 1, 2, 0xfe, 0xff}
+	shares, err := gen.ShamirSplit(secret, 5, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	texts := make([]string, len(shares))
+	for i, s := range shares {
+		texts[i] = s.String()
+		if !strings.HasPrefix(texts[i], "bsgss1-") {
+			t.Fatalf("share text %q", texts[i])
+		}
+	}
+	got, err := gen.ShamirCombineText([]string{texts[4], texts[0], " " + texts[2] + "\n"})
+	if err != nil || !bytes.Equal(got, secret) {
+		t.Fatalf("ShamirCombineText = %x, %v", got, err)
+	}
+
+	var s gen.Share
+	if err := s.UnmarshalText([]byte(texts[1])); err != nil || s.Index != 2 || s.Threshold != 3 {
+		t.Fatalf("UnmarshalText = %+v, %v", s, err)
+	}
+
+	// Corrupt one character of the data part.
+	last := texts[1][len(texts[1])-1]
+	repl := byte('2')
+	if last == '2' {
+		repl = '3'
+	}
+	bad := []string{
+		texts[1][:len(texts[1])-1] + string(repl),
+		strings.Replace(texts[1], "-3-2-", "-3-4-", 1),
+		strings.Replace(texts[1], "-3-2-", "-2-2-", 1),
+		strings.Replace(texts[1], "bsgss1", "bsgss2", 1),
+		"bsgss1-00-3-2-abc",
+		"",
+	}
+	for _, b := range bad {
+		if _, err := gen.ParseShare(b); !errors.Is(err, gen.ErrInvalidShare) {
+			t.Errorf("ParseShare(%q): want ErrInvalidShare, got %v", b, err)
+		}
+	}
+}
+
+func TestShamirMixedSets(t *testing.T) {
+	a, _ := gen.ShamirSplit([]byte("first secret"), 3, 2)
+	b, _ := gen.ShamirSplit([]byte("other secret"), 3, 2)
+	if _, err := gen.ShamirCombine([]gen.Share{a[0], b[1]}); !errors.Is(err, gen.ErrShareMismatch) {
+		t.Errorf("mixed sets: %v", err)
+	}
+	if _, err := gen.ShamirCombine([]gen.Share{a[0], a[0]}); !errors.Is(err, gen.ErrDuplicateShare) {
+		t.Errorf("duplicate: %v", err)
+	}
+	if _, err := gen.ShamirCombine(nil); !errors.Is(err, gen.ErrTooFewShares) {
+		t.Errorf("no shares: %v", err)
+	}
+}
+
+func TestShamirSplitArguments(t *testing.T) {
+	tests := []struct {
+		name   string
+		secret []byte
+		n, k   int
+	}{
+		{"empty secret", nil, 3, 2},
+		{"threshold one", []byte("x"), 3, 1},
+		{"fewer shares than threshold", []byte("x"), 2, 3},
+		{"too many shares", []byte("x"), 256, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := gen.ShamirSplit(tt.secret, tt.n, tt.k)
+			if !errors.Is(err, gen.ErrInvalidArgument) {
+				t.Fatalf("want ErrInvalidArgument, got %v", err)
+			}
+		})
+	}
+}
+
+func TestShamirSeeded(t *testing.T) {
+	g1 := gen.NewSeededGenerator([32]byte{1})
+	g2 := gen.NewSeededGenerator([32]byte{1})
+	a, _ := gen.ShamirSplitFrom(g1, []byte("seeded"), 3, 2)
+	b, _ := gen.ShamirSplitFrom(g2, []byte("seeded"), 3, 2)
+	for i := range a {
+		if a[i].String() != b[i].String() {
+			t.Fatalf("share %d differs: %s != %s", i, a[i], b[i])
+		}
+	}
+	// A single share must not equal the secret for k >= 2 in general.
+	if bytes.Equal(a[0].Data, []byte("seeded")) && bytes.Equal(a[1].Data, []byte("seeded")) {
+		t.Fatal("shares leak the secret")
+	}
+}
@@ -49,39 +49,55 @@
 package gen
 
 import (
-	"crypto/rand"
 	hx "encoding/hex"
-	"fmt"
-	"math/big"
+	"strings"
 )
 
 // Default Character set for Random length text generation.
 const CharSet = "0123456789abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
 
+// NanoIDAlphabet is the default URL safe alphabet of NanoID.
+const NanoIDAlphabet = "useandom-26T198340PX75pxJACKVERYMINDBUSHWOLF_GQZbfghjklqvwyzrict"
+
+// NanoIDSize is the default length of a NanoID.
+const NanoIDSize = 21
+
 // String generates a cryptographically secure random string.
 // charset: the set of characters to select from.
 // length: the desired length of the output string.
 func String(charset string, length int) (string, error) {
-	if length <= 0 {
-		return "", fmt.Errorf("length must be positive")
-	}
-	runes := []rune(charset)
-	if len(runes) == 0 {
-		return "", fmt.Errorf("charset must not be empty")
-	}
+	return defaultGenerator.String(charset, length)
+}
 
-	result := make([]rune, length)
-	max := big.NewInt(int64(len(runes)))
+// NanoID generates a URL safe NanoID of the default length.
+func NanoID() (string, error) {
+	return String(NanoIDAlphabet, NanoIDSize)
+}
 
-	for i := 0; i < length; i++ {
-		n, err := rand.Int(rand.Reader, max)
-		if err != nil {
-			return "", fmt.Errorf("failed to generate random index: %w", err)
+// NanoIDCustom generates a NanoID from the alphabet with the given
+// length. Any charset accepted by String works, including UTF-8.
+func NanoIDCustom(alphabet string, size int) (string, error) {
+	return String(alphabet, size)
+}
+
+// ValidNanoID reports if id has the given length and only uses
+// characters from the alphabet. An empty alphabet or a size of zero
+// selects the defaults.
+func ValidNanoID(id, alphabet string, size int) bool {
+	if alphabet == "" {
+		alphabet = NanoIDAlphabet
+	}
+	if size == 0 {
+		size = NanoIDSize
+	}
+	n := 0
+	for _, r := range id {
+		if !strings.ContainsRune(alphabet, r) {
+			return false
 		}
-		result[i] = runes[n.Int64()]
+		n++
 	}
-
-	return string(result), nil
+	return n == size
 }
 
 // Hex helps to print the bytes in Hex String format.
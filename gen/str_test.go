@@ -201,3 +201,30 @@ func TestHex(t *testing.T) {
 		})
 	}
 }
+
+func TestNanoID(t *testing.T) {
+	id, err := gen.NanoID()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(id) != gen.NanoIDSize || !gen.ValidNanoID(id, "", 0) {
+		t.Errorf("NanoID() = %q is not valid", id)
+	}
+
+	id, err = gen.NanoIDCustom("0123456789abcdef", 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !gen.ValidNanoID(id, "0123456789abcdef", 10) {
+		t.Errorf("NanoIDCustom() = %q is not valid", id)
+	}
+	if gen.ValidNanoID(id, "0123456789abcdef", 11) {
+		t.Error("ValidNanoID accepted the wrong length")
+	}
+	if gen.ValidNanoID("abc!efghijklmnopqrstu", "", 0) {
+		t.Error("ValidNanoID accepted a character outside the alphabet")
+	}
+	if _, err := gen.NanoIDCustom("", 10); err == nil {
+		t.Error("expected error for empty alphabet")
+	}
+}
@@ -0,0 +1,279 @@
+// stream.go - Part of the `gen` Package
+//
+//     ॐ भूर्भुवः स्वः
+//     तत्स॑वि॒तुर्वरे॑ण्यं॒
+//    भर्गो॑ दे॒वस्य॑ धीमहि।
+//   धियो॒ यो नः॑ प्रचो॒दया॑त्॥
+//
+//
+//  बी.स.जी - बोसजी के द्वारा रचित सुरक्षा एवं गोपनीयता हेतु तन्त्राक्ष्।
+// ================================================
+//
+// एक सुरक्षा एवं गोपनीयता केंद्रित तंत्राक्षों का संकलन।
+//
+// एक रचनात्मक भारतीय उत्पाद ।
+//
+// bsg - Boseji's Security and Privacy Utilities
+//
+// A collection of Security and Privacy utilities and some notes for help.
+//
+// This is **Golang** package collection as well as few utility
+// command line programs.
+//
+// Sources
+// -------
+// https://github.com/boseji/bsg
+//
+// License
+// -------
+//
+//   bsg - Boseji's Security and Privacy Utilities.
+//   Copyright (C) 2025-2026 by Abhijit Bose (aka. Boseji)
+//
+//   This program is free software: you can redistribute it and/or modify
+//   it under the terms of the GNU General Public License version 2 only
+//   as published by the Free Software Foundation.
+//
+//   This program is distributed in the hope that it will be useful,
+//   but WITHOUT ANY WARRANTY; without even the implied warranty of
+//   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+//
+//   You should have received a copy of the GNU General Public License
+//   along with this program. If not, see <https://www.gnu.org/licenses/>.
+//
+//  SPDX-License-Identifier: GPL-2.0-only
+//  Full Name: GNU General Public License v2.0 only
+//  Please visit <https://spdx.org/licenses/GPL-2.0-only.html> for details.
+//
+
+package gen
+
+import (
+	"context"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha3"
+	"crypto/sha512"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+)
+
+// DefaultBufferSize is the size of the read buffer used while hashing
+// a stream.
+const DefaultBufferSize = 64 * 1024
+
+// StreamOptions holds configuration parameters for hashing streams.
+type StreamOptions struct {
+	BufferSize int                     // Size of each read (default is 64 KiB).
+	Progress   func(done, total int64) // Optional callback after each read.
+}
+
+// StreamOption is a function that modifies StreamOptions.
+type StreamOption func(*StreamOptions)
+
+// WithBufferSize sets the size of the buffer used for each read.
+func WithBufferSize(size int) StreamOption {
+	return func(opts *StreamOptions) {
+		opts.BufferSize = size
+	}
+}
+
+// WithProgress sets a callback that receives the number of bytes hashed
+// so far and the total size if known, or -1 otherwise.
+func WithProgress(fn func(done, total int64)) StreamOption {
+	return func(opts *StreamOptions) {
+		opts.Progress = fn
+	}
+}
+
+// HashReader computes several digests of the reader in a single pass.
+// The digests are returned in the same order as the hash constructors.
+// Reading stops with the context error if ctx is cancelled.
+func HashReader(ctx context.Context, r io.Reader, hashes []func() hash.Hash,
+	opts ...StreamOption) ([][]byte, error) {
+	return hashStream(ctx, r, -1, hashes, opts)
+}
+
+// HashFile computes several digests of the file at path in a single pass.
+func HashFile(ctx context.Context, path string, hashes []func() hash.Hash,
+	opts ...StreamOption) ([][]byte, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	total := int64(-1)
+	if fi, err := f.Stat(); err == nil && fi.Mode().IsRegular() {
+		total = fi.Size()
+	}
+	return hashStream(ctx, f, total, hashes, opts)
+}
+
+// SumReader computes a single digest of the reader.
+func SumReader(ctx context.Context, r io.Reader, h func() hash.Hash,
+	opts ...StreamOption) ([]byte, error) {
+	sums, err := HashReader(ctx, r, []func() hash.Hash{h}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return sums[0], nil
+}
+
+// SumFile computes a single digest of the file at path.
+func SumFile(ctx context.Context, path string, h func() hash.Hash,
+	opts ...StreamOption) ([]byte, error) {
+	sums, err := HashFile(ctx, path, []func() hash.Hash{h}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return sums[0], nil
+}
+
+// SHA1Reader computes the SHA-1 hash of the reader.
+func SHA1Reader(r io.Reader) ([]byte, error) {
+	return SumReader(context.Background(), r, sha1.New)
+}
+
+// SHA256Reader computes the SHA-256 hash of the reader.
+func SHA256Reader(r io.Reader) ([]byte, error) {
+	return SumReader(context.Background(), r, sha256.New)
+}
+
+// SHA384Reader computes the SHA-384 hash of the reader.
+func SHA384Reader(r io.Reader) ([]byte, error) {
+	return SumReader(context.Background(), r, sha512.New384)
+}
+
+// SHA512Reader computes the SHA-512 hash of the reader.
+func SHA512Reader(r io.Reader) ([]byte, error) {
+	return SumReader(context.Background(), r, sha512.New)
+}
+
+// SHA3_256Reader computes the SHA3-256 hash of the reader.
+func SHA3_256Reader(r io.Reader) ([]byte, error) {
+	return SumReader(context.Background(), r, newSHA3_256)
+}
+
+// SHA3_512Reader computes the SHA3-512 hash of the reader.
+func SHA3_512Reader(r io.Reader) ([]byte, error) {
+	return SumReader(context.Background(), r, newSHA3_512)
+}
+
+// SHA1File computes the SHA-1 hash of the file at path.
+func SHA1File(path string) ([]byte, error) {
+	return SumFile(context.Background(), path, sha1.New)
+}
+
+// SHA256File computes the SHA-256 hash of the file at path.
+func SHA256File(path string) ([]byte, error) {
+	return SumFile(context.Background(), path, sha256.New)
+}
+
+// SHA384File computes the SHA-384 hash of the file at path.
+func SHA384File(path string) ([]byte, error) {
+	return SumFile(context.Background(), path, sha512.New384)
+}
+
+// SHA512File computes the SHA-512 hash of the file at path.
+func SHA512File(path string) ([]byte, error) {
+	return SumFile(context.Background(), path, sha512.New)
+}
+
+// SHA3_256File computes the SHA3-256 hash of the file at path.
+func SHA3_256File(path string) ([]byte, error) {
+	return SumFile(context.Background(), path, newSHA3_256)
+}
+
+// SHA3_512File computes the SHA3-512 hash of the file at path.
+func SHA3_512File(path string) ([]byte, error) {
+	return SumFile(context.Background(), path, newSHA3_512)
+}
+
+// SHAKE128Reader returns a SHAKE128 hash of the reader with the given
+// length (in bytes). Like SumReader it stops once ctx is cancelled.
+func SHAKE128Reader(ctx context.Context, r io.Reader, length int,
+	opts ...StreamOption) ([]byte, error) {
+	return shakeStream(ctx, r, sha3.NewSHAKE128, length, opts)
+}
+
+// SHAKE256Reader returns a SHAKE256 hash of the reader with the given
+// length (in bytes). Like SumReader it stops once ctx is cancelled.
+func SHAKE256Reader(ctx context.Context, r io.Reader, length int,
+	opts ...StreamOption) ([]byte, error) {
+	return shakeStream(ctx, r, sha3.NewSHAKE256, length, opts)
+}
+
+// newSHA3_256 adapts sha3.New256 to the hash.Hash constructor form.
+func newSHA3_256() hash.Hash { return sha3.New256() }
+
+// newSHA3_512 adapts sha3.New512 to the hash.Hash constructor form.
+func newSHA3_512() hash.Hash { return sha3.New512() }
+
+// hashStream feeds the reader through all the hashes using one buffer.
+func hashStream(ctx context.Context, r io.Reader, total int64,
+	hashes []func() hash.Hash, opts []StreamOption) ([][]byte, error) {
+	options := StreamOptions{BufferSize: DefaultBufferSize}
+	for _, opt := range opts {
+		opt(&options)
+	}
+	if options.BufferSize <= 0 {
+		return nil, fmt.Errorf("buffer size must be positive")
+	}
+	if len(hashes) == 0 {
+		return nil, fmt.Errorf("no hash supplied")
+	}
+
+	hs := make([]hash.Hash, len(hashes))
+	ws := make([]io.Writer, len(hashes))
+	for i, h := range hashes {
+		hs[i] = h()
+		ws[i] = hs[i]
+	}
+	w := io.MultiWriter(ws...)
+
+	buf := make([]byte, options.BufferSize)
+	var done int64
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		n, err := r.Read(buf)
+		if n > 0 {
+			w.Write(buf[:n]) // hash.Hash never returns an error
+			done += int64(n)
+			if options.Progress != nil {
+				options.Progress(done, total)
+			}
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	sums := make([][]byte, len(hs))
+	for i, h := range hs {
+		sums[i] = h.Sum(nil)
+	}
+	return sums, nil
+}
+
+// shakeStream hashes the stream with a SHAKE function squeezing out the
+// requested length.
+func shakeStream(ctx context.Context, r io.Reader, xof func() *sha3.SHAKE,
+	length int, opts []StreamOption) ([]byte, error) {
+	if length < 0 {
+		return nil, fmt.Errorf("%w: SHAKE length must be >= 0", ErrInvalidArgument)
+	}
+	h := func() hash.Hash { return &shakeHash{s: xof(), xof: xof, size: length} }
+	sums, err := hashStream(ctx, r, -1, []func() hash.Hash{h}, opts)
+	if err != nil {
+		return nil, err
+	}
+	return sums[0], nil
+}
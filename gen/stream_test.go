@@ -0,0 +1,247 @@
+// stream_test.go - Test Program `gen` Package
+//
+//     ॐ भूर्भुवः स्वः
+//     तत्स॑वि॒तुर्वरे॑ण्यं॒
+//    भर्गो॑ दे॒वस्य॑ धीमहि।
+//   धियो॒ यो नः॑ प्रचो॒दया॑त्॥
+//
+//
+//  बी.स.जी - बोसजी के द्वारा रचित सुरक्षा एवं गोपनीयता हेतु तन्त्राक्ष्।
+// ================================================
+//
+// एक सुरक्षा एवं गोपनीयता केंद्रित तंत्राक्षों का संकलन।
+//
+// एक रचनात्मक भारतीय उत्पाद ।
+//
+// bsg - Boseji's Security and Privacy Utilities
+//
+// A collection of Security and Privacy utilities and some notes for help.
+//
+// This is **Golang** package collection as well as few utility
+// command line programs.
+//
+// Sources
+// -------
+// https://github.com/boseji/bsg
+//
+// License
+// -------
+//
+//   bsg - Boseji's Security and Privacy Utilities.
+//   Copyright (C) 2025-2026 by Abhijit Bose (aka. Boseji)
+//
+//   This program is free software: you can redistribute it and/or modify
+//   it under the terms of the GNU General Public License version 2 only
+//   as published by the Free Software Foundation.
+//
+//   This program is distributed in the hope that it will be useful,
+//   but WITHOUT ANY WARRANTY; without even the implied warranty of
+//   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+//
+//   You should have received a copy of the GNU General Public License
+//   along with this program. If not, see <https://www.gnu.org/licenses/>.
+//
+//  SPDX-License-Identifier: GPL-2.0-only
+//  Full Name: GNU General Public License v2.0 only
+//  Please visit <https://spdx.org/licenses/GPL-2.0-only.html> for details.
+//
+
+package gen_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"crypto/sha512"
+	"errors"
+	"hash"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/boseji/bsg/gen"
+)
+
+func TestHashReader(t *testing.T) {
+	data := bytes.Repeat([]byte("abc"), 100000)
+
+	sums, err := gen.HashReader(context.Background(), bytes.NewReader(data),
+		[]func() hash.Hash{sha256.New, sha512.New},
+		gen.WithBufferSize(1000),
+	)
+	if err != nil {
+		t.Fatalf("HashReader failed: %v", err)
+	}
+	if len(sums) != 2 {
+		t.Fatalf("expected 2 digests, got %d", len(sums))
+	}
+	if !bytes.Equal(sums[0], gen.SHA256(data)) {
+		t.Errorf("SHA256 mismatch: got %x, expected %x", sums[0], gen.SHA256(data))
+	}
+	if !bytes.Equal(sums[1], gen.SHA512(data)) {
+		t.Errorf("SHA512 mismatch: got %x, expected %x", sums[1], gen.SHA512(data))
+	}
+}
+
+func TestHashReaderProgress(t *testing.T) {
+	data := make([]byte, 10000)
+	var calls int
+	var last int64
+
+	_, err := gen.SumReader(context.Background(), bytes.NewReader(data),
+		sha256.New,
+		gen.WithBufferSize(1024),
+		gen.WithProgress(func(done, total int64) {
+			calls++
+			if done <= last {
+				t.Errorf("progress did not advance: %d after %d", done, last)
+			}
+			if total != -1 {
+				t.Errorf("expected unknown total, got %d", total)
+			}
+			last = done
+		}),
+	)
+	if err != nil {
+		t.Fatalf("SumReader failed: %v", err)
+	}
+	if calls != 10 {
+		t.Errorf("expected 10 progress calls, got %d", calls)
+	}
+	if last != int64(len(data)) {
+		t.Errorf("expected %d bytes hashed, got %d", len(data), last)
+	}
+}
+
+func TestHashReaderCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	data := make([]byte, 10000)
+
+	_, err := gen.SumReader(ctx, bytes.NewReader(data), sha256.New,
+		gen.WithBufferSize(100),
+		gen.WithProgress(func(done, total int64) {
+			if done >= 500 {
+				cancel()
+			}
+		}),
+	)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context.Canceled, got %v", err)
+	}
+}
+
+func TestHashReaderInvalid(t *testing.T) {
+	r := bytes.NewReader(nil)
+	if _, err := gen.HashReader(context.Background(), r, nil); err == nil {
+		t.Error("expected error for no hashes, got nil")
+	}
+	if _, err := gen.SumReader(context.Background(), r, sha256.New,
+		gen.WithBufferSize(0)); err == nil {
+		t.Error("expected error for zero buffer size, got nil")
+	}
+}
+
+func TestSHAKEReader(t *testing.T) {
+	data := make([]byte, 1000)
+	var calls int
+	sum, err := gen.SHAKE256Reader(context.Background(), bytes.NewReader(data), 48,
+		gen.WithBufferSize(100),
+		gen.WithProgress(func(done, total int64) { calls++ }))
+	if err != nil || !bytes.Equal(sum, gen.SHAKE256(data, 48)) {
+		t.Errorf("SHAKE256Reader = %x, %v; expected %x", sum, err, gen.SHAKE256(data, 48))
+	}
+	if calls != 10 {
+		t.Errorf("expected 10 progress calls, got %d", calls)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := gen.SHAKE128Reader(ctx, bytes.NewReader(data), 32); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context.Canceled, got %v", err)
+	}
+	if _, err := gen.SHAKE128Reader(context.Background(), bytes.NewReader(data), -1); !errors.Is(err, gen.ErrInvalidArgument) {
+		t.Errorf("expected ErrInvalidArgument for a negative length, got %v", err)
+	}
+}
+
+func TestHashFile(t *testing.T) {
+	data := bytes.Repeat([]byte("0123456789"), 5000)
+	path := filepath.Join(t.TempDir(), "data.bin")
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	var total int64
+	sum, err := gen.SumFile(context.Background(), path, sha256.New,
+		gen.WithProgress(func(_, t int64) { total = t }))
+	if err != nil {
+		t.Fatalf("SumFile failed: %v", err)
+	}
+	if !bytes.Equal(sum, gen.SHA256(data)) {
+		t.Errorf("SumFile mismatch: got %x, expected %x", sum, gen.SHA256(data))
+	}
+	if total != int64(len(data)) {
+		t.Errorf("expected total %d, got %d", len(data), total)
+	}
+
+	if _, err := gen.SHA256File(filepath.Join(t.TempDir(), "missing")); err == nil {
+		t.Error("expected error for missing file, got nil")
+	}
+}
+
+func TestStreamVariants(t *testing.T) {
+	ctx := context.Background()
+	data := []byte("abc")
+	path := filepath.Join(t.TempDir(), "abc.txt")
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name   string
+		oneOff []byte
+		reader func() ([]byte, error)
+		file   func() ([]byte, error)
+	}{
+		{"SHA1", gen.SHA1(data),
+			func() ([]byte, error) { return gen.SHA1Reader(bytes.NewReader(data)) },
+			func() ([]byte, error) { return gen.SHA1File(path) }},
+		{"SHA256", gen.SHA256(data),
+			func() ([]byte, error) { return gen.SHA256Reader(bytes.NewReader(data)) },
+			func() ([]byte, error) { return gen.SHA256File(path) }},
+		{"SHA384", gen.SHA384(data),
+			func() ([]byte, error) { return gen.SHA384Reader(bytes.NewReader(data)) },
+			func() ([]byte, error) { return gen.SHA384File(path) }},
+		{"SHA512", gen.SHA512(data),
+			func() ([]byte, error) { return gen.SHA512Reader(bytes.NewReader(data)) },
+			func() ([]byte, error) { return gen.SHA512File(path) }},
+		{"SHA3_256", gen.SHA3_256(data),
+			func() ([]byte, error) { return gen.SHA3_256Reader(bytes.NewReader(data)) },
+			func() ([]byte, error) { return gen.SHA3_256File(path) }},
+		{"SHA3_512", gen.SHA3_512(data),
+			func() ([]byte, error) { return gen.SHA3_512Reader(bytes.NewReader(data)) },
+			func() ([]byte, error) { return gen.SHA3_512File(path) }},
+		{"SHAKE128", gen.SHAKE128(data, 32),
+			func() ([]byte, error) { return gen.SHAKE128Reader(ctx, bytes.NewReader(data), 32) },
+			nil},
+		{"SHAKE256", gen.SHAKE256(data, 64),
+			func() ([]byte, error) { return gen.SHAKE256Reader(ctx, bytes.NewReader(data), 64) },
+			nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.reader()
+			if err != nil || !bytes.Equal(got, tt.oneOff) {
+				t.Errorf("Reader = %x, %v; expected %x", got, err, tt.oneOff)
+			}
+			if tt.file == nil {
+				return
+			}
+			got, err = tt.file()
+			if err != nil || !bytes.Equal(got, tt.oneOff) {
+				t.Errorf("File = %x, %v; expected %x", got, err, tt.oneOff)
+			}
+		})
+	}
+}
@@ -0,0 +1,232 @@
+// ulid.go - Part of the `gen` Package
+//
+//     ॐ भूर्भुवः स्वः
+//     तत्स॑वि॒तुर्वरे॑ण्यं॒
+//    भर्गो॑ दे॒वस्य॑ धीमहि।
+//   धियो॒ यो नः॑ प्रचो॒दया॑त्॥
+//
+//
+//  बी.स.जी - बोसजी के द्वारा रचित सुरक्षा एवं गोपनीयता हेतु तन्त्राक्ष्।
+// ================================================
+//
+// एक सुरक्षा एवं गोपनीयता केंद्रित तंत्राक्षों का संकलन।
+//
+// एक रचनात्मक भारतीय उत्पाद ।
+//
+// bsg - Boseji's Security and Privacy Utilities
+//
+// A collection of Security and Privacy utilities and some notes for help.
+//
+// This is **Golang** package collection as well as few utility
+// command line programs.
+//
+// Sources
+// -------
+// https://github.com/boseji/bsg
+//
+// License
+// -------
+//
+//   bsg - Boseji's Security and Privacy Utilities.
+//   Copyright (C) 2025-2026 by Abhijit Bose (aka. Boseji)
+//
+//   This program is free software: you can redistribute it and/or modify
+//   it under the terms of the GNU General Public License version 2 only
+//   as published by the Free Software Foundation.
+//
+//   This program is distributed in the hope that it will be useful,
+//   but WITHOUT ANY WARRANTY; without even the implied warranty of
+//   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+//
+//   You should have received a copy of the GNU General Public License
+//   along with this program. If not, see <https://www.gnu.org/licenses/>.
+//
+//  SPDX-License-Identifier: GPL-2.0-only
+//  Full Name: GNU General Public License v2.0 only
+//  Please visit <https://spdx.org/licenses/GPL-2.0-only.html> for details.
+//
+
+package gen
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+var (
+	ErrInvalidULID  = errors.New("invalid ULID")
+	ErrULIDOverflow = errors.New("ULID random part overflow in millisecond")
+)
+
+// ULID is a Universally Unique Lexicographically Sortable Identifier:
+// a 48 bit millisecond timestamp followed by 80 random bits.
+// See https://github.com/ulid/spec
+type ULID [16]byte
+
+// ulidAlphabet is the Crockford base32 alphabet used by ULIDs.
+const ulidAlphabet = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+// ulidState keeps the last value of NewMonotonicULID.
+var ulidState struct {
+	sync.Mutex
+	last ULID
+}
+
+// NewULID generates a ULID with a fresh random part.
+func NewULID() (ULID, error) {
+	var u ULID
+	if _, err := defaultGenerator.Read(u[6:]); err != nil {
+		return ULID{}, err
+	}
+	u.setTime(time.Now().UnixMilli())
+	return u, nil
+}
+
+// NewMonotonicULID generates a ULID that is strictly greater than the
+// previous one from this function. Within the same millisecond the
+// random part of the last value is incremented by one, as the spec asks,
+// and ErrULIDOverflow is returned in the unlikely case it runs out.
+func NewMonotonicULID() (ULID, error) {
+	ulidState.Lock()
+	defer ulidState.Unlock()
+
+	ms := time.Now().UnixMilli()
+	last := ulidState.last
+	if ms <= last.Time().UnixMilli() && last != (ULID{}) {
+		u := last
+		for i := len(u) - 1; i >= 6; i-- {
+			u[i]++
+			if u[i] != 0 {
+				ulidState.last = u
+				return u, nil
+			}
+		}
+		return ULID{}, ErrULIDOverflow
+	}
+
+	u, err := NewULID()
+	if err != nil {
+		return ULID{}, err
+	}
+	u.setTime(ms)
+	ulidState.last = u
+	return u, nil
+}
+
+// ParseULID parses the 26 character form. Case is ignored and the
+// Crockford aliases I, L and O are accepted.
+func ParseULID(s string) (ULID, error) {
+	if len(s) != 26 {
+		return ULID{}, fmt.Errorf("%w: %q has wrong length", ErrInvalidULID, s)
+	}
+	var digits [26]byte
+	for i := 0; i < len(s); i++ {
+		v, ok := crockfordValue(s[i])
+		if !ok {
+			return ULID{}, fmt.Errorf("%w: %q has bad character", ErrInvalidULID, s)
+		}
+		digits[i] = v
+	}
+	// 26 characters carry 130 bits, the top two must be zero.
+	if digits[0] > 7 {
+		return ULID{}, fmt.Errorf("%w: %q overflows 128 bits", ErrInvalidULID, s)
+	}
+
+	var u ULID
+	var acc uint
+	var nbits uint
+	j := len(u) - 1
+	for i := len(digits) - 1; i >= 0; i-- {
+		acc |= uint(digits[i]) << nbits
+		nbits += 5
+		for nbits >= 8 && j >= 0 {
+			u[j] = byte(acc)
+			acc >>= 8
+			nbits -= 8
+			j--
+		}
+	}
+	if j >= 0 {
+		u[j] = byte(acc)
+	}
+	return u, nil
+}
+
+// ValidULID reports if s is a well formed ULID.
+func ValidULID(s string) bool {
+	_, err := ParseULID(s)
+	return err == nil
+}
+
+// String returns the 26 character Crockford base32 form.
+func (u ULID) String() string {
+	var b [26]byte
+	var acc uint
+	var nbits uint
+	j := len(b) - 1
+	for i := len(u) - 1; i >= 0; i-- {
+		acc |= uint(u[i]) << nbits
+		nbits += 8
+		for nbits >= 5 {
+			b[j] = ulidAlphabet[acc&0x1f]
+			acc >>= 5
+			nbits -= 5
+			j--
+		}
+	}
+	b[0] = ulidAlphabet[acc&0x1f]
+	return string(b[:])
+}
+
+// Time returns the timestamp of the ULID.
+func (u ULID) Time() time.Time {
+	ms := int64(u[0])<<40 | int64(u[1])<<32 | int64(u[2])<<24 |
+		int64(u[3])<<16 | int64(u[4])<<8 | int64(u[5])
+	return time.UnixMilli(ms)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (u ULID) MarshalText() ([]byte, error) {
+	return []byte(u.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (u *ULID) UnmarshalText(b []byte) error {
+	v, err := ParseULID(string(b))
+	if err != nil {
+		return err
+	}
+	*u = v
+	return nil
+}
+
+// setTime stores the millisecond timestamp in the first 6 bytes.
+func (u *ULID) setTime(ms int64) {
+	u[0] = byte(ms >> 40)
+	u[1] = byte(ms >> 32)
+	u[2] = byte(ms >> 24)
+	u[3] = byte(ms >> 16)
+	u[4] = byte(ms >> 8)
+	u[5] = byte(ms)
+}
+
+// crockfordValue decodes one Crockford base32 character.
+func crockfordValue(c byte) (byte, bool) {
+	if c >= 'a' && c <= 'z' {
+		c -= 'a' - 'A'
+	}
+	switch c {
+	case 'O':
+		return 0, true
+	case 'I', 'L':
+		return 1, true
+	}
+	for i := 0; i < len(ulidAlphabet); i++ {
+		if ulidAlphabet[i] == c {
+			return byte(i), true
+		}
+	}
+	return 0, false
+}
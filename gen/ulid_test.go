@@ -0,0 +1,142 @@
+// ulid_test.go - Test Program `gen` Package
+//
+//     ॐ भूर्भुवः स्वः
+//     तत्स॑वि॒तुर्वरे॑ण्यं॒
+//    भर्गो॑ दे॒वस्य॑ धीमहि।
+//   धियो॒ यो नः॑ प्रचो॒दया॑त्॥
+//
+//
+//  बी.स.जी - बोसजी के द्वारा रचित सुरक्षा एवं गोपनीयता हेतु तन्त्राक्ष्।
+// ================================================
+//
+// एक सुरक्षा एवं गोपनीयता केंद्रित तंत्राक्षों का संकलन।
+//
+// एक रचनात्मक भारतीय उत्पाद ।
+//
+// bsg - Boseji's Security and Privacy Utilities
+//
+// A collection of Security and Privacy utilities and some notes for help.
+//
+// This is **Golang** package collection as well as few utility
+// command line programs.
+//
+// Sources
+// -------
+// https://github.com/boseji/bsg
+//
+// License
+// -------
+//
+//   bsg - Boseji's Security and Privacy Utilities.
+//   Copyright (C) 2025-2026 by Abhijit Bose (aka. Boseji)
+//
+//   This program is free software: you can redistribute it and/or modify
+//   it under the terms of the GNU General Public License version 2 only
+//   as published by the Free Software Foundation.
+//
+//   This program is distributed in the hope that it will be useful,
+//   but WITHOUT ANY WARRANTY; without even the implied warranty of
+//   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+//
+//   You should have received a copy of the GNU General Public License
+//   along with this program. If not, see <https://www.gnu.org/licenses/>.
+//
+//  SPDX-License-Identifier: GPL-2.0-only
+//  Full Name: GNU General Public License v2.0 only
+//  Please visit <https://spdx.org/licenses/GPL-2.0-only.html> for details.
+//
+
+package gen_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/boseji/bsg/gen"
+)
+
+// TestULIDSpec uses the timestamp example of the ULID specification.
+func TestULIDSpec(t *testing.T) {
+	u, err := gen.ParseULID("01ARYZ6S41TSV4RRFFQ69G5FAV")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ms := u.Time().UnixMilli(); ms != 1469918176385 {
+		t.Errorf("Time() = %d ms, expected 1469918176385", ms)
+	}
+	if u.String() != "01ARYZ6S41TSV4RRFFQ69G5FAV" {
+		t.Errorf("round trip gave %s", u)
+	}
+
+	// Case and Crockford aliases are accepted
+	v, err := gen.ParseULID("01aryz6s41tsv4rrffq69g5fav")
+	if err != nil || v != u {
+		t.Errorf("lower case parse = %s, %v", v, err)
+	}
+	w, err := gen.ParseULID("0LARYZ6S4ITSV4RRFFQ69G5FAV")
+	if err != nil || w.String() != "01ARYZ6S41TSV4RRFFQ69G5FAV" {
+		t.Errorf("alias parse = %s, %v", w, err)
+	}
+}
+
+func TestULIDLimits(t *testing.T) {
+	max := "7ZZZZZZZZZZZZZZZZZZZZZZZZZ"
+	u, err := gen.ParseULID(max)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, b := range u {
+		if b != 0xff {
+			t.Fatalf("max ULID decoded to %x", u)
+		}
+	}
+	if u.String() != max {
+		t.Errorf("max ULID round trip gave %s", u)
+	}
+
+	for _, in := range []string{
+		"8ZZZZZZZZZZZZZZZZZZZZZZZZZ",
+		"01ARYZ6S41TSV4RRFFQ69G5FA",
+		"01ARYZ6S41TSV4RRFFQ69G5FAU",
+	} {
+		if _, err := gen.ParseULID(in); !errors.Is(err, gen.ErrInvalidULID) {
+			t.Errorf("ParseULID(%q) error = %v, expected ErrInvalidULID", in, err)
+		}
+	}
+}
+
+func TestNewULID(t *testing.T) {
+	before := time.Now().Add(-time.Millisecond)
+	u, err := gen.NewULID()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ts := u.Time(); ts.Before(before) || ts.After(time.Now()) {
+		t.Errorf("ULID time %v outside expected window", ts)
+	}
+	if !gen.ValidULID(u.String()) {
+		t.Errorf("ValidULID(%s) = false", u)
+	}
+
+	var back gen.ULID
+	if err := back.UnmarshalText([]byte(strings.ToLower(u.String()))); err != nil || back != u {
+		t.Errorf("UnmarshalText = %s, %v", back, err)
+	}
+}
+
+func TestNewMonotonicULID(t *testing.T) {
+	var last string
+	for i := 0; i < 10000; i++ {
+		u, err := gen.NewMonotonicULID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if s := u.String(); s <= last {
+			t.Fatalf("ULID not increasing: %s after %s", s, last)
+		} else {
+			last = s
+		}
+	}
+}
@@ -0,0 +1,215 @@
+// uuid.go - Part of the `gen` Package
+//
+//     ॐ भूर्भुवः स्वः
+//     तत्स॑वि॒तुर्वरे॑ण्यं॒
+//    भर्गो॑ दे॒वस्य॑ धीमहि।
+//   धियो॒ यो नः॑ प्रचो॒दया॑त्॥
+//
+//
+//  बी.स.जी - बोसजी के द्वारा रचित सुरक्षा एवं गोपनीयता हेतु तन्त्राक्ष्।
+// ================================================
+//
+// एक सुरक्षा एवं गोपनीयता केंद्रित तंत्राक्षों का संकलन।
+//
+// एक रचनात्मक भारतीय उत्पाद ।
+//
+// bsg - Boseji's Security and Privacy Utilities
+//
+// A collection of Security and Privacy utilities and some notes for help.
+//
+// This is **Golang** package collection as well as few utility
+// command line programs.
+//
+// Sources
+// -------
+// https://github.com/boseji/bsg
+//
+// License
+// -------
+//
+//   bsg - Boseji's Security and Privacy Utilities.
+//   Copyright (C) 2025-2026 by Abhijit Bose (aka. Boseji)
+//
+//   This program is free software: you can redistribute it and/or modify
+//   it under the terms of the GNU General Public License version 2 only
+//   as published by the Free Software Foundation.
+//
+//   This program is distributed in the hope that it will be useful,
+//   but WITHOUT ANY WARRANTY; without even the implied warranty of
+//   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+//
+//   You should have received a copy of the GNU General Public License
+//   along with this program. If not, see <https://www.gnu.org/licenses/>.
+//
+//  SPDX-License-Identifier: GPL-2.0-only
+//  Full Name: GNU General Public License v2.0 only
+//  Please visit <https://spdx.org/licenses/GPL-2.0-only.html> for details.
+//
+
+package gen
+
+import (
+	"encoding/binary"
+	hx "encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+var ErrInvalidUUID = errors.New("invalid UUID")
+
+// UUID is a 128 bit universally unique identifier as per RFC 9562.
+type UUID [16]byte
+
+// NilUUID is the UUID with all bits set to zero.
+var NilUUID UUID
+
+// uuidv7State keeps UUIDv7 values monotonic within this process.
+var uuidv7State struct {
+	sync.Mutex
+	ms      int64
+	counter uint16
+}
+
+// NewUUIDv4 generates a random (version 4) UUID.
+func NewUUIDv4() (UUID, error) {
+	var u UUID
+	if _, err := defaultGenerator.Read(u[:]); err != nil {
+		return NilUUID, err
+	}
+	u[6] = u[6]&0x0f | 0x40
+	u[8] = u[8]&0x3f | 0x80
+	return u, nil
+}
+
+// NewUUIDv7 generates a time ordered (version 7) UUID. The 12 bits after
+// the millisecond timestamp hold a counter, as in method 1 of RFC 9562
+// section 6.2, so values made in the same millisecond still sort in
+// order of creation.
+func NewUUIDv7() (UUID, error) {
+	var u UUID
+	if _, err := defaultGenerator.Read(u[6:]); err != nil {
+		return NilUUID, err
+	}
+
+	uuidv7State.Lock()
+	ms := time.Now().UnixMilli()
+	if ms <= uuidv7State.ms {
+		// Same millisecond or the clock went back, keep counting.
+		ms = uuidv7State.ms
+		uuidv7State.counter++
+		if uuidv7State.counter > 0x0fff {
+			ms++
+			uuidv7State.counter = 0
+		}
+	} else {
+		// Start from a random value leaving half the room for counting.
+		uuidv7State.counter = binary.BigEndian.Uint16(u[6:8]) & 0x07ff
+	}
+	uuidv7State.ms = ms
+	counter := uuidv7State.counter
+	uuidv7State.Unlock()
+
+	u[0] = byte(ms >> 40)
+	u[1] = byte(ms >> 32)
+	u[2] = byte(ms >> 24)
+	u[3] = byte(ms >> 16)
+	u[4] = byte(ms >> 8)
+	u[5] = byte(ms)
+	binary.BigEndian.PutUint16(u[6:8], 0x7000|counter)
+	u[8] = u[8]&0x3f | 0x80
+	return u, nil
+}
+
+// ParseUUID parses the standard "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+// form, optionally in braces or with a "urn:uuid:" prefix, as well as
+// 32 hex digits without hyphens.
+func ParseUUID(s string) (UUID, error) {
+	var u UUID
+	t := strings.TrimPrefix(strings.ToLower(s), "urn:uuid:")
+	if strings.HasPrefix(t, "{") && strings.HasSuffix(t, "}") {
+		t = t[1 : len(t)-1]
+	}
+	switch len(t) {
+	case 36:
+		if t[8] != '-' || t[13] != '-' || t[18] != '-' || t[23] != '-' {
+			return NilUUID, fmt.Errorf("%w: %q", ErrInvalidUUID, s)
+		}
+		t = t[:8] + t[9:13] + t[14:18] + t[19:23] + t[24:]
+	case 32:
+	default:
+		return NilUUID, fmt.Errorf("%w: %q", ErrInvalidUUID, s)
+	}
+	if _, err := hx.Decode(u[:], []byte(t)); err != nil {
+		return NilUUID, fmt.Errorf("%w: %q", ErrInvalidUUID, s)
+	}
+	return u, nil
+}
+
+// ValidUUID reports if s parses as a UUID with the RFC 9562 variant.
+func ValidUUID(s string) bool {
+	u, err := ParseUUID(s)
+	return err == nil && u.Variant() == 2
+}
+
+// String returns the standard hyphenated lower case form.
+func (u UUID) String() string {
+	var b [36]byte
+	hx.Encode(b[0:8], u[0:4])
+	b[8] = '-'
+	hx.Encode(b[9:13], u[4:6])
+	b[13] = '-'
+	hx.Encode(b[14:18], u[6:8])
+	b[18] = '-'
+	hx.Encode(b[19:23], u[8:10])
+	b[23] = '-'
+	hx.Encode(b[24:], u[10:])
+	return string(b[:])
+}
+
+// Version returns the version number of the UUID.
+func (u UUID) Version() int {
+	return int(u[6] >> 4)
+}
+
+// Variant returns the variant of the UUID, 2 for RFC 9562 UUIDs.
+func (u UUID) Variant() int {
+	switch {
+	case u[8]&0x80 == 0:
+		return 0
+	case u[8]&0xc0 == 0x80:
+		return 2
+	case u[8]&0xe0 == 0xc0:
+		return 6
+	default:
+		return 7
+	}
+}
+
+// Time returns the creation time of a version 7 UUID. The boolean is
+// false for other versions.
+func (u UUID) Time() (time.Time, bool) {
+	if u.Version() != 7 {
+		return time.Time{}, false
+	}
+	var b [8]byte
+	copy(b[2:], u[:6])
+	return time.UnixMilli(int64(binary.BigEndian.Uint64(b[:]))), true
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (u UUID) MarshalText() ([]byte, error) {
+	return []byte(u.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (u *UUID) UnmarshalText(b []byte) error {
+	v, err := ParseUUID(string(b))
+	if err != nil {
+		return err
+	}
+	*u = v
+	return nil
+}
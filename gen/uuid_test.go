@@ -0,0 +1,173 @@
+// uuid_test.go - Test Program `gen` Package
+//
+//     ॐ भूर्भुवः स्वः
+//     तत्स॑वि॒तुर्वरे॑ण्यं॒
+//    भर्गो॑ दे॒वस्य॑ धीमहि।
+//   धियो॒ यो नः॑ प्रचो॒दया॑त्॥
+//
+//
+//  बी.स.जी - बोसजी के द्वारा रचित सुरक्षा एवं गोपनीयता हेतु तन्त्राक्ष्।
+// ================================================
+//
+// एक सुरक्षा एवं गोपनीयता केंद्रित तंत्राक्षों का संकलन।
+//
+// एक रचनात्मक भारतीय उत्पाद ।
+//
+// bsg - Boseji's Security and Privacy Utilities
+//
+// A collection of Security and Privacy utilities and some notes for help.
+//
+// This is **Golang** package collection as well as few utility
+// command line programs.
+//
+// Sources
+// -------
+// https://github.com/boseji/bsg
+//
+// License
+// -------
+//
+//   bsg - Boseji's Security and Privacy Utilities.
+//   Copyright (C) 2025-2026 by Abhijit Bose (aka. Boseji)
+//
+//   This program is free software: you can redistribute it and/or modify
+//   it under the terms of the GNU General Public License version 2 only
+//   as published by the Free Software Foundation.
+//
+//   This program is distributed in the hope that it will be useful,
+//   but WITHOUT ANY WARRANTY; without even the implied warranty of
+//   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+//
+//   You should have received a copy of the GNU General Public License
+//   along with this program. If not, see <https://www.gnu.org/licenses/>.
+//
+//  SPDX-License-Identifier: GPL-2.0-only
+//  Full Name: GNU General Public License v2.0 only
+//  Please visit <https://spdx.org/licenses/GPL-2.0-only.html> for details.
+//
+
+package gen_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/boseji/bsg/gen"
+)
+
+func TestNewUUIDv4(t *testing.T) {
+	seen := map[gen.UUID]bool{}
+	for i := 0; i < 1000; i++ {
+		u, err := gen.NewUUIDv4()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if u.Version() != 4 || u.Variant() != 2 {
+			t.Fatalf("%s has version %d variant %d", u, u.Version(), u.Variant())
+		}
+		if seen[u] {
+			t.Fatalf("duplicate UUID %s", u)
+		}
+		seen[u] = true
+		if !gen.ValidUUID(u.String()) {
+			t.Fatalf("ValidUUID(%s) = false", u)
+		}
+		if _, ok := u.Time(); ok {
+			t.Fatalf("version 4 UUID must not have a time")
+		}
+	}
+}
+
+func TestNewUUIDv7(t *testing.T) {
+	before := time.Now().Add(-time.Millisecond)
+	var last string
+	for i := 0; i < 10000; i++ {
+		u, err := gen.NewUUIDv7()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if u.Version() != 7 || u.Variant() != 2 {
+			t.Fatalf("%s has version %d variant %d", u, u.Version(), u.Variant())
+		}
+		// Monotonic even within the same millisecond
+		if s := u.String(); s <= last {
+			t.Fatalf("UUIDv7 not increasing: %s after %s", s, last)
+		} else {
+			last = s
+		}
+	}
+
+	u, _ := gen.NewUUIDv7()
+	ts, ok := u.Time()
+	// The counter may have pushed the time a little ahead
+	if !ok || ts.Before(before) || ts.After(time.Now().Add(time.Second)) {
+		t.Errorf("UUIDv7 time %v outside expected window", ts)
+	}
+}
+
+// TestUUIDv7Example uses the example of RFC 9562 Appendix A.6.
+func TestUUIDv7Example(t *testing.T) {
+	u, err := gen.ParseUUID("017F22E2-79B0-7CC3-98C4-DC0C0C07398F")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ts, ok := u.Time()
+	if !ok || ts.UnixMilli() != 0x017F22E279B0 {
+		t.Errorf("Time() = %v (%d ms), expected %d ms", ts, ts.UnixMilli(), 0x017F22E279B0)
+	}
+	if u.String() != "017f22e2-79b0-7cc3-98c4-dc0c0c07398f" {
+		t.Errorf("String() = %s", u)
+	}
+}
+
+func TestParseUUID(t *testing.T) {
+	const canonical = "f81d4fae-7dec-11d0-a765-00a0c91e6bf6"
+	for _, in := range []string{
+		canonical,
+		"F81D4FAE-7DEC-11D0-A765-00A0C91E6BF6",
+		"urn:uuid:" + canonical,
+		"{" + canonical + "}",
+		"f81d4fae7dec11d0a76500a0c91e6bf6",
+	} {
+		u, err := gen.ParseUUID(in)
+		if err != nil {
+			t.Errorf("ParseUUID(%q) error: %v", in, err)
+			continue
+		}
+		if u.String() != canonical || u.Version() != 1 {
+			t.Errorf("ParseUUID(%q) = %s version %d", in, u, u.Version())
+		}
+	}
+
+	for _, in := range []string{
+		"",
+		"f81d4fae-7dec-11d0-a765-00a0c91e6bf",
+		"f81d4fae_7dec_11d0_a765_00a0c91e6bf6",
+		"g81d4fae-7dec-11d0-a765-00a0c91e6bf6",
+	} {
+		if _, err := gen.ParseUUID(in); !errors.Is(err, gen.ErrInvalidUUID) {
+			t.Errorf("ParseUUID(%q) error = %v, expected ErrInvalidUUID", in, err)
+		}
+	}
+	// Wrong variant
+	if gen.ValidUUID("f81d4fae-7dec-11d0-2765-00a0c91e6bf6") {
+		t.Error("ValidUUID accepted a non RFC 9562 variant")
+	}
+}
+
+func TestUUIDJSON(t *testing.T) {
+	u, _ := gen.NewUUIDv4()
+	b, err := json.Marshal(map[string]gen.UUID{"id": u})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var back map[string]gen.UUID
+	if err := json.Unmarshal(b, &back); err != nil {
+		t.Fatal(err)
+	}
+	if back["id"] != u {
+		t.Errorf("JSON round trip gave %s, expected %s", back["id"], u)
+	}
+}
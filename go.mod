@@ -3,3 +3,5 @@ module github.com/boseji/bsg
 go 1.26
 
 require golang.org/x/crypto v0.53.0
+
+require golang.org/x/sys v0.46.0 // indirect
@@ -0,0 +1,215 @@
+// applescript.go - Part of the `kyb` Package
+//
+//     ॐ भूर्भुवः स्वः
+//     तत्स॑वि॒तुर्वरे॑ण्यं॒
+//    भर्गो॑ दे॒वस्य॑ धीमहि।
+//   धियो॒ यो नः॑ प्रचो॒दया॑त्॥
+//
+//
+//  बी.स.जी - बोसजी के द्वारा रचित सुरक्षा एवं गोपनीयता हेतु तन्त्राक्ष्।
+// ================================================
+//
+// एक सुरक्षा एवं गोपनीयता केंद्रित तंत्राक्षों का संकलन।
+//
+// एक रचनात्मक भारतीय उत्पाद ।
+//
+// bsg - Boseji's Security and Privacy Utilities
+//
+// A collection of Security and Privacy utilities and some notes for help.
+//
+// This is **Golang** package collection as well as few utility
+// command line programs.
+//
+// Sources
+// -------
+// https://github.com/boseji/bsg
+//
+// License
+// -------
+//
+//   bsg - Boseji's Security and Privacy Utilities.
+//   Copyright (C) 2025-2026 by Abhijit Bose (aka. Boseji)
+//
+//   This program is free software: you can redistribute it and/or modify
+//   it under the terms of the GNU General Public License version 2 only
+//   as published by the Free Software Foundation.
+//
+//   This program is distributed in the hope that it will be useful,
+//   but WITHOUT ANY WARRANTY; without even the implied warranty of
+//   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+//
+//   You should have received a copy of the GNU General Public License
+//   along with this program. If not, see <https://www.gnu.org/licenses/>.
+//
+//  SPDX-License-Identifier: GPL-2.0-only
+//  Full Name: GNU General Public License v2.0 only
+//  Please visit <https://spdx.org/licenses/GPL-2.0-only.html> for details.
+//
+
+package kyb
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// The macOS backend drives System Events through AppleScript run by
+// osascript. The scripts are built here, without build tags, so the
+// quoting can be tested on any OS; kyb_darwin.go only runs them, feeding
+// the script over stdin so typed text never shows up in argv.
+
+// macModifiers maps modifier keys to their System Events names
+var macModifiers = map[string]string{
+	"shift": "shift",
+	"ctrl":  "control",
+	"alt":   "option",
+	"altgr": "option",
+	"logo":  "command",
+}
+
+// appleScriptString quotes s as an AppleScript string expression.
+// Printable ASCII goes into a literal with \ and " escaped, any other
+// character is spelled (character id N). The script stays plain ASCII,
+// so quotes, newlines or emoji in s can neither end the literal early nor
+// be mangled by the text encoding osascript reads the script with.
+func appleScriptString(s string) string {
+	var parts []string
+	var lit strings.Builder
+	flush := func() {
+		if lit.Len() > 0 {
+			parts = append(parts, `"`+lit.String()+`"`)
+			lit.Reset()
+		}
+	}
+
+	for _, r := range s {
+		switch {
+		case r == '\\' || r == '"':
+			lit.WriteByte('\\')
+			lit.WriteRune(r)
+		case r >= ' ' && r <= '~':
+			lit.WriteRune(r)
+		default:
+			flush()
+			parts = append(parts, "(character id "+strconv.Itoa(int(r))+")")
+		}
+	}
+	flush()
+
+	switch len(parts) {
+	case 0:
+		return `""`
+	case 1:
+		return parts[0]
+	}
+	return "(" + strings.Join(parts, " & ") + ")"
+}
+
+// tellSystemEvents wraps the statements in a System Events tell block
+func tellSystemEvents(statements ...string) string {
+	return "tell application \"System Events\"\n\t" +
+		strings.Join(statements, "\n\t") + "\nend tell\n"
+}
+
+// typeScript builds the script that types text, waiting delay between
+// keystrokes. Without a delay runs of characters go out as one
+// keystroke. Newlines and tabs press the return and tab keys, with CRLF
+// counted as one return, as keystroke would type them as characters.
+func typeScript(text string, delay time.Duration) (string, error) {
+	var steps []string
+	var run []rune
+	flush := func() {
+		if len(run) > 0 {
+			steps = append(steps, "keystroke "+appleScriptString(string(run)))
+			run = run[:0]
+		}
+	}
+
+	var prev rune
+	for _, r := range text {
+		switch {
+		case r == '\n' && prev == '\r':
+			// CRLF already pressed return
+		case r == '\n' || r == '\r' || r == '\t':
+			flush()
+			name := "enter"
+			if r == '\t' {
+				name = "tab"
+			}
+			steps = append(steps, "key code "+strconv.Itoa(int(namedKeys[name].mac)))
+		case r < ' ' || r == 0x7f:
+			return "", fmt.Errorf("%w: cannot type control character %U", ErrNotSupported, r)
+		default:
+			run = append(run, r)
+			if delay > 0 {
+				flush()
+			}
+		}
+		prev = r
+	}
+	flush()
+
+	if len(steps) == 0 {
+		return "", nil
+	}
+	if delay > 0 {
+		pause := "delay " + strconv.FormatFloat(delay.Seconds(), 'f', -1, 64)
+		for i := len(steps) - 1; i > 0; i-- {
+			steps = slices.Insert(steps, i, pause)
+		}
+	}
+	return tellSystemEvents(steps...), nil
+}
+
+// keyPressScript builds the script that presses a key or chord, with the
+// modifiers given as "using {...}"
+func keyPressScript(key string) (string, error) {
+	mods, k, err := parseChord(key)
+	if err != nil {
+		return "", err
+	}
+
+	var using []string
+	for _, m := range mods {
+		using = append(using, macModifiers[m.mod]+" down")
+	}
+	if k.shift && !slices.Contains(using, "shift down") {
+		using = append(using, "shift down")
+	}
+
+	statement := "key code " + strconv.Itoa(int(k.mac))
+	if len(using) > 0 {
+		statement += " using {" + strings.Join(using, ", ") + "}"
+	}
+	return tellSystemEvents(statement), nil
+}
+
+// keyToggleScript builds the script that presses the modifiers of a
+// chord in order, or releases them in reverse. System Events has no way
+// to hold other keys.
+func keyToggleScript(key string, down bool) (string, error) {
+	mods, k, err := parseChord(key)
+	if err != nil {
+		return "", err
+	}
+	if k.mod == "" {
+		return "", fmt.Errorf("%w: only modifiers can be held on macOS, not %q", ErrNotSupported, k.name)
+	}
+
+	mods = append(mods, k)
+	action := "key up "
+	if down {
+		action = "key down "
+	} else {
+		slices.Reverse(mods)
+	}
+
+	statements := make([]string, len(mods))
+	for i, m := range mods {
+		statements[i] = action + macModifiers[m.mod]
+	}
+	return tellSystemEvents(statements...), nil
+}
@@ -0,0 +1,150 @@
+// applescript_test.go - Test Program `kyb` Package
+//
+//     ॐ भूर्भुवः स्वः
+//     तत्स॑वि॒तुर्वरे॑ण्यं॒
+//    भर्गो॑ दे॒वस्य॑ धीमहि।
+//   धियो॒ यो नः॑ प्रचो॒दया॑त्॥
+//
+//
+//  बी.स.जी - बोसजी के द्वारा रचित सुरक्षा एवं गोपनीयता हेतु तन्त्राक्ष्।
+// ================================================
+//
+// एक सुरक्षा एवं गोपनीयता केंद्रित तंत्राक्षों का संकलन।
+//
+// एक रचनात्मक भारतीय उत्पाद ।
+//
+// bsg - Boseji's Security and Privacy Utilities
+//
+// A collection of Security and Privacy utilities and some notes for help.
+//
+// This is **Golang** package collection as well as few utility
+// command line programs.
+//
+// Sources
+// -------
+// https://github.com/boseji/bsg
+//
+// License
+// -------
+//
+//   bsg - Boseji's Security and Privacy Utilities.
+//   Copyright (C) 2025-2026 by Abhijit Bose (aka. Boseji)
+//
+//   This program is free software: you can redistribute it and/or modify
+//   it under the terms of the GNU General Public License version 2 only
+//   as published by the Free Software Foundation.
+//
+//   This program is distributed in the hope that it will be useful,
+//   but WITHOUT ANY WARRANTY; without even the implied warranty of
+//   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+//
+//   You should have received a copy of the GNU General Public License
+//   along with this program. If not, see <https://www.gnu.org/licenses/>.
+//
+//  SPDX-License-Identifier: GPL-2.0-only
+//  Full Name: GNU General Public License v2.0 only
+//  Please visit <https://spdx.org/licenses/GPL-2.0-only.html> for details.
+//
+
+package kyb
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestAppleScriptString(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"", `""`},
+		{"hello world", `"hello world"`},
+		{`a"b\c`, `"a\"b\\c"`},
+		{"é", `(character id 233)`},
+		{"\n", `(character id 10)`},
+		{"pa\"ss é😀!", `("pa\"ss " & (character id 233) & (character id 128512) & "!")`},
+		{`"; do shell script "id"; "`, `"\"; do shell script \"id\"; \""`},
+	}
+	for _, tc := range tests {
+		if got := appleScriptString(tc.in); got != tc.want {
+			t.Errorf("appleScriptString(%q) = %s, want %s", tc.in, got, tc.want)
+		}
+	}
+}
+
+func TestTypeScript(t *testing.T) {
+	tests := []struct {
+		text  string
+		delay time.Duration
+		want  string
+	}{
+		{"", 0, ""},
+		{"user\tp\"w\\\r\nnaïve", 0, "tell application \"System Events\"\n" +
+			"\tkeystroke \"user\"\n" +
+			"\tkey code 48\n" +
+			"\tkeystroke \"p\\\"w\\\\\"\n" +
+			"\tkey code 36\n" +
+			"\tkeystroke (\"na\" & (character id 239) & \"ve\")\n" +
+			"end tell\n"},
+		{"a\nb", 20 * time.Millisecond, "tell application \"System Events\"\n" +
+			"\tkeystroke \"a\"\n" +
+			"\tdelay 0.02\n" +
+			"\tkey code 36\n" +
+			"\tdelay 0.02\n" +
+			"\tkeystroke \"b\"\n" +
+			"end tell\n"},
+	}
+	for _, tc := range tests {
+		got, err := typeScript(tc.text, tc.delay)
+		if err != nil {
+			t.Errorf("typeScript(%q): %v", tc.text, err)
+			continue
+		}
+		if got != tc.want {
+			t.Errorf("typeScript(%q, %s) =\n%s\nwant\n%s", tc.text, tc.delay, got, tc.want)
+		}
+	}
+
+	if _, err := typeScript("a\x1bb", 0); !errors.Is(err, ErrNotSupported) {
+		t.Errorf("control character: err = %v, want ErrNotSupported", err)
+	}
+}
+
+func TestKeyScripts(t *testing.T) {
+	tests := []struct {
+		key  string
+		want string
+	}{
+		{"enter", "key code 36"},
+		{"ctrl+shift+a", "key code 0 using {control down, shift down}"},
+		{"cmd+v", "key code 9 using {command down}"},
+		{"A", "key code 0 using {shift down}"},
+		{"alt+F4", "key code 118 using {option down}"},
+	}
+	for _, tc := range tests {
+		got, err := keyPressScript(tc.key)
+		if err != nil {
+			t.Errorf("keyPressScript(%q): %v", tc.key, err)
+			continue
+		}
+		if want := tellSystemEvents(tc.want); got != want {
+			t.Errorf("keyPressScript(%q) = %q, want %q", tc.key, got, want)
+		}
+	}
+	if _, err := keyPressScript("hyper"); !errors.Is(err, ErrUnknownKey) {
+		t.Errorf("unknown key: err = %v, want ErrUnknownKey", err)
+	}
+
+	down, err := keyToggleScript("ctrl+shift", true)
+	if want := tellSystemEvents("key down control", "key down shift"); err != nil || down != want {
+		t.Errorf("keyToggleScript down = %q, %v, want %q", down, err, want)
+	}
+	up, err := keyToggleScript("ctrl+shift", false)
+	if want := tellSystemEvents("key up shift", "key up control"); err != nil || up != want {
+		t.Errorf("keyToggleScript up = %q, %v, want %q", up, err, want)
+	}
+	if _, err := keyToggleScript("a", true); !errors.Is(err, ErrNotSupported) {
+		t.Errorf("holding a: err = %v, want ErrNotSupported", err)
+	}
+}
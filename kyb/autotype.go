@@ -0,0 +1,420 @@
+// autotype.go - Part of the `kyb` Package
+//
+//     ॐ भूर्भुवः स्वः
+//     तत्स॑वि॒तुर्वरे॑ण्यं॒
+//    भर्गो॑ दे॒वस्य॑ धीमहि।
+//   धियो॒ यो नः॑ प्रचो॒दया॑त्॥
+//
+//
+//  बी.स.जी - बोसजी के द्वारा रचित सुरक्षा एवं गोपनीयता हेतु तन्त्राक्ष्।
+// ================================================
+//
+// एक सुरक्षा एवं गोपनीयता केंद्रित तंत्राक्षों का संकलन।
+//
+// एक रचनात्मक भारतीय उत्पाद ।
+//
+// bsg - Boseji's Security and Privacy Utilities
+//
+// A collection of Security and Privacy utilities and some notes for help.
+//
+// This is **Golang** package collection as well as few utility
+// command line programs.
+//
+// Sources
+// -------
+// https://github.com/boseji/bsg
+//
+// License
+// -------
+//
+//   bsg - Boseji's Security and Privacy Utilities.
+//   Copyright (C) 2025-2026 by Abhijit Bose (aka. Boseji)
+//
+//   This program is free software: you can redistribute it and/or modify
+//   it under the terms of the GNU General Public License version 2 only
+//   as published by the Free Software Foundation.
+//
+//   This program is distributed in the hope that it will be useful,
+//   but WITHOUT ANY WARRANTY; without even the implied warranty of
+//   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+//
+//   You should have received a copy of the GNU General Public License
+//   along with this program. If not, see <https://www.gnu.org/licenses/>.
+//
+//  SPDX-License-Identifier: GPL-2.0-only
+//  Full Name: GNU General Public License v2.0 only
+//  Please visit <https://spdx.org/licenses/GPL-2.0-only.html> for details.
+//
+
+package kyb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+var (
+	// ErrInvalidSequence is returned for auto-type sequences that do not parse
+	ErrInvalidSequence = errors.New("invalid auto-type sequence")
+	// ErrUnknownPlaceholder is returned for placeholders without a field
+	ErrUnknownPlaceholder = errors.New("unknown auto-type placeholder")
+)
+
+// maxRepeat limits repeat counts such as {TAB 3}
+const maxRepeat = 1000
+
+// autoTypeKeys maps the KeePass names of special keys to kyb key names
+var autoTypeKeys = map[string]string{
+	"TAB": "tab", "ENTER": "enter", "SPACE": "space",
+	"BACKSPACE": "backspace", "BS": "backspace", "BKSP": "backspace",
+	"DELETE": "delete", "DEL": "delete", "INSERT": "insert", "INS": "insert",
+	"HOME": "home", "END": "end", "PGUP": "pageup", "PGDN": "pagedown",
+	"UP": "up", "DOWN": "down", "LEFT": "left", "RIGHT": "right",
+	"ESC": "escape", "CAPSLOCK": "capslock",
+	"WIN": "super", "LWIN": "super", "RWIN": "super",
+	"F1": "f1", "F2": "f2", "F3": "f3", "F4": "f4", "F5": "f5", "F6": "f6",
+	"F7": "f7", "F8": "f8", "F9": "f9", "F10": "f10", "F11": "f11", "F12": "f12",
+}
+
+// autoTypeMods maps the modifier prefixes to kyb key names
+var autoTypeMods = map[byte]string{'^': "ctrl", '+': "shift", '%': "alt"}
+
+// Action is one step of an auto-type plan
+type Action struct {
+	Kind  EventKind
+	Value string        // text for EventType, the key otherwise
+	Field string        // placeholder the text comes from, "" for literal text
+	Delay time.Duration // pause for EventSleep
+}
+
+// String describes the action without revealing field values, e.g.
+// `type {PASSWORD}` or `press "ctrl+a"`
+func (a Action) String() string {
+	switch {
+	case a.Kind == EventSleep:
+		return fmt.Sprintf("%s %s", a.Kind, a.Delay)
+	case a.Field != "":
+		return fmt.Sprintf("%s {%s}", a.Kind, a.Field)
+	}
+	return fmt.Sprintf("%s %q", a.Kind, a.Value)
+}
+
+// AutoType is a parsed KeePass style auto-type sequence such as
+// "{USERNAME}{TAB}{PASSWORD}{ENTER}". Literal characters are typed as
+// they are, and braces hold placeholders:
+//
+//   - special keys like {TAB}, {ENTER}, {ESC}, {F5} or {PGDN}
+//   - repeats like {TAB 3} or {a 5}
+//   - pauses like {DELAY 500} in milliseconds
+//   - virtual key codes like {VKEY 13} or {VKEY 0x0D}
+//   - escapes of the special characters: {+} {^} {%} {~} {(} {)} {{} {}}
+//   - any other name is a field such as {PASSWORD}, resolved when run
+//
+// The prefixes ^ (ctrl), + (shift) and % (alt) press the next key with
+// the modifier held, or hold it around a group in parentheses as in
+// "+(abc)". A bare ~ presses enter.
+type AutoType struct {
+	actions []Action
+}
+
+// ParseAutoType checks a sequence and compiles it into actions
+func ParseAutoType(seq string) (*AutoType, error) {
+	p := &autoTypeParser{seq: seq}
+	if err := p.parse(0); err != nil {
+		return nil, err
+	}
+	return &AutoType{actions: p.actions}, nil
+}
+
+// Plan resolves the fields of the sequence, matched case-insensitively,
+// and returns the actions Run would perform. Field values are kept as
+// they are; braces in a password are never read as placeholders.
+func (a *AutoType) Plan(fields map[string]string) ([]Action, error) {
+	upper := make(map[string]string, len(fields))
+	for k, v := range fields {
+		upper[strings.ToUpper(k)] = v
+	}
+
+	plan := make([]Action, len(a.actions))
+	for i, act := range a.actions {
+		if act.Field != "" {
+			v, ok := upper[act.Field]
+			if !ok {
+				return nil, fmt.Errorf("%w: {%s}", ErrUnknownPlaceholder, act.Field)
+			}
+			act.Value = v
+		}
+		plan[i] = act
+	}
+	return plan, nil
+}
+
+// Run resolves the fields and performs the sequence through Type,
+// KeyPress, KeyDown and KeyUp of the active backend. Keys still held when
+// an action fails are released before returning.
+func (a *AutoType) Run(fields map[string]string) error {
+	return a.run(fields, nil)
+}
+
+// run performs the plan, calling verify, if set, before every action and
+// before every TargetChunkSize characters of typed text, so a focus
+// change in the middle of a long field is caught too
+func (a *AutoType) run(fields map[string]string, verify func() error) error {
+	plan, err := a.Plan(fields)
+	if err != nil {
+		return err
+	}
+
+	var held []string
+	defer func() {
+		for i := len(held) - 1; i >= 0; i-- {
+			KeyUp(held[i])
+		}
+	}()
+
+	for _, act := range plan {
+		// Typed text is checked chunk by chunk below
+		if verify != nil && act.Kind != EventSleep && act.Kind != EventType {
+			if err := verify(); err != nil {
+				return err
+			}
+		}
+
+		switch act.Kind {
+		case EventType:
+			if verify != nil {
+				err = TypeWithOptions(context.Background(), act.Value,
+					WithChunkSize(TargetChunkSize), withVerify(verify))
+			} else {
+				err = Type(act.Value)
+			}
+		case EventKeyPress:
+			err = KeyPress(act.Value)
+		case EventKeyDown:
+			if err = KeyDown(act.Value); err == nil {
+				held = append(held, act.Value)
+			}
+		case EventKeyUp:
+			if err = KeyUp(act.Value); err == nil {
+				held = held[:len(held)-1]
+			}
+		case EventSleep:
+			time.Sleep(act.Delay)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DryRun writes the plan to w, one action per line, without sending any
+// key. Field values are shown by name only.
+func (a *AutoType) DryRun(w io.Writer, fields map[string]string) error {
+	plan, err := a.Plan(fields)
+	if err != nil {
+		return err
+	}
+	for _, act := range plan {
+		if _, err := fmt.Fprintln(w, act); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RunAutoType parses and runs a sequence in one go
+func RunAutoType(seq string, fields map[string]string) error {
+	a, err := ParseAutoType(seq)
+	if err != nil {
+		return err
+	}
+	return a.Run(fields)
+}
+
+// autoTypeParser compiles a sequence into actions
+type autoTypeParser struct {
+	seq     string
+	pos     int
+	actions []Action
+}
+
+func (p *autoTypeParser) errorf(format string, args ...any) error {
+	return fmt.Errorf("%w at %d: %s", ErrInvalidSequence, p.pos, fmt.Sprintf(format, args...))
+}
+
+// parse reads items until the end, or the closing parenthesis of a group
+// at depth > 0
+func (p *autoTypeParser) parse(depth int) error {
+	for p.pos < len(p.seq) {
+		c := p.seq[p.pos]
+		if c == ')' {
+			if depth == 0 {
+				return p.errorf("unmatched )")
+			}
+			p.pos++
+			return nil
+		}
+		if err := p.item(); err != nil {
+			return err
+		}
+	}
+	if depth > 0 {
+		return p.errorf("unclosed (")
+	}
+	return nil
+}
+
+// item reads the modifier prefixes and the key, placeholder or group they
+// apply to
+func (p *autoTypeParser) item() error {
+	var mods []string
+	for p.pos < len(p.seq) {
+		mod, ok := autoTypeMods[p.seq[p.pos]]
+		if !ok {
+			break
+		}
+		mods = append(mods, mod)
+		p.pos++
+	}
+	if len(mods) > 0 && p.pos == len(p.seq) {
+		return p.errorf("modifier without a key")
+	}
+	chord := strings.Join(mods, "+")
+
+	switch p.seq[p.pos] {
+	case '(':
+		if len(mods) == 0 {
+			return p.errorf("group without a modifier")
+		}
+		p.pos++
+		p.actions = append(p.actions, Action{Kind: EventKeyDown, Value: chord})
+		if err := p.parse(1); err != nil {
+			return err
+		}
+		p.actions = append(p.actions, Action{Kind: EventKeyUp, Value: chord})
+		return nil
+	case '{':
+		return p.placeholder(chord)
+	case '~':
+		p.pos++
+		return p.key(chord, "enter", 1)
+	}
+
+	r, size := utf8.DecodeRuneInString(p.seq[p.pos:])
+	p.pos += size
+	if chord == "" {
+		p.literal(string(r))
+		return nil
+	}
+	return p.key(chord, string(r), 1)
+}
+
+// placeholder reads a {...} item
+func (p *autoTypeParser) placeholder(chord string) error {
+	start := p.pos
+	body := ""
+	if strings.HasPrefix(p.seq[p.pos:], "{}}") {
+		body = "}"
+		p.pos += 3
+	} else {
+		end := strings.IndexByte(p.seq[p.pos+1:], '}')
+		if end < 0 {
+			return p.errorf("unclosed {")
+		}
+		body = p.seq[p.pos+1 : p.pos+1+end]
+		p.pos += end + 2
+	}
+	if body == "" {
+		p.pos = start
+		return p.errorf("empty {}")
+	}
+
+	name, arg, hasArg := strings.Cut(body, " ")
+	if utf8.RuneCountInString(body) == 1 {
+		name, hasArg = body, false
+	}
+	arg = strings.TrimSpace(arg)
+	upper := strings.ToUpper(name)
+
+	switch {
+	case upper == "DELAY" && hasArg:
+		if chord != "" {
+			return p.errorf("modifier on {DELAY}")
+		}
+		ms, err := strconv.Atoi(arg)
+		if err != nil || ms < 0 {
+			return p.errorf("bad delay %q", arg)
+		}
+		p.actions = append(p.actions, Action{Kind: EventSleep, Delay: time.Duration(ms) * time.Millisecond})
+		return nil
+	case upper == "VKEY" && hasArg:
+		code, err := strconv.ParseUint(arg, 0, 16)
+		key, ok := lookupVK(uint16(code))
+		if err != nil || !ok {
+			return p.errorf("unsupported virtual key %q", arg)
+		}
+		return p.key(chord, key.name, 1)
+	}
+
+	key, special := autoTypeKeys[upper]
+	single := utf8.RuneCountInString(name) == 1
+	if !special && !single {
+		// Fields may contain spaces, as in {S:Card Number}
+		if chord != "" {
+			return p.errorf("modifier on field {%s}", body)
+		}
+		p.actions = append(p.actions, Action{Kind: EventType, Field: strings.ToUpper(body)})
+		return nil
+	}
+
+	count := 1
+	if hasArg {
+		n, err := strconv.Atoi(arg)
+		if err != nil || n < 0 || n > maxRepeat {
+			return p.errorf("bad repeat count %q", arg)
+		}
+		count = n
+	}
+
+	switch {
+	case special:
+		return p.key(chord, key, count)
+	case chord == "":
+		p.literal(strings.Repeat(name, count))
+		return nil
+	}
+	return p.key(chord, name, count)
+}
+
+// key adds count presses of a key with the modifiers of chord held
+func (p *autoTypeParser) key(chord, key string, count int) error {
+	if chord != "" {
+		key = chord + "+" + key
+	}
+	if _, _, err := parseChord(key); err != nil {
+		return p.errorf("%v", err)
+	}
+	for range count {
+		p.actions = append(p.actions, Action{Kind: EventKeyPress, Value: key})
+	}
+	return nil
+}
+
+// literal adds text to type, joining it with literal text just before
+func (p *autoTypeParser) literal(text string) {
+	if n := len(p.actions); n > 0 {
+		last := &p.actions[n-1]
+		if last.Kind == EventType && last.Field == "" {
+			last.Value += text
+			return
+		}
+	}
+	p.actions = append(p.actions, Action{Kind: EventType, Value: text})
+}
@@ -0,0 +1,200 @@
+// autotype_test.go - Test Program `kyb` Package
+//
+//     ॐ भूर्भुवः स्वः
+//     तत्स॑वि॒तुर्वरे॑ण्यं॒
+//    भर्गो॑ दे॒वस्य॑ धीमहि।
+//   धियो॒ यो नः॑ प्रचो॒दया॑त्॥
+//
+//
+//  बी.स.जी - बोसजी के द्वारा रचित सुरक्षा एवं गोपनीयता हेतु तन्त्राक्ष्।
+// ================================================
+//
+// एक सुरक्षा एवं गोपनीयता केंद्रित तंत्राक्षों का संकलन।
+//
+// एक रचनात्मक भारतीय उत्पाद ।
+//
+// bsg - Boseji's Security and Privacy Utilities
+//
+// A collection of Security and Privacy utilities and some notes for help.
+//
+// This is **Golang** package collection as well as few utility
+// command line programs.
+//
+// Sources
+// -------
+// https://github.com/boseji/bsg
+//
+// License
+// -------
+//
+//   bsg - Boseji's Security and Privacy Utilities.
+//   Copyright (C) 2025-2026 by Abhijit Bose (aka. Boseji)
+//
+//   This program is free software: you can redistribute it and/or modify
+//   it under the terms of the GNU General Public License version 2 only
+//   as published by the Free Software Foundation.
+//
+//   This program is distributed in the hope that it will be useful,
+//   but WITHOUT ANY WARRANTY; without even the implied warranty of
+//   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+//
+//   You should have received a copy of the GNU General Public License
+//   along with this program. If not, see <https://www.gnu.org/licenses/>.
+//
+//  SPDX-License-Identifier: GPL-2.0-only
+//  Full Name: GNU General Public License v2.0 only
+//  Please visit <https://spdx.org/licenses/GPL-2.0-only.html> for details.
+//
+
+package kyb
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestParseAutoType checks the compiled plan of a range of sequences.
+func TestParseAutoType(t *testing.T) {
+	fields := map[string]string{
+		"UserName":      "alice",
+		"password":      "p{a}ss+word~",
+		"TOTP":          "123456",
+		"S:Card Number": "4111",
+	}
+	tests := []struct {
+		seq  string
+		want []string
+	}{
+		{"{USERNAME}{TAB}{PASSWORD}{TOTP}{ENTER}", []string{
+			"type {USERNAME}", `press "tab"`, "type {PASSWORD}", "type {TOTP}", `press "enter"`}},
+		{"abc{TAB 3}def~", []string{
+			`type "abc"`, `press "tab"`, `press "tab"`, `press "tab"`, `type "def"`, `press "enter"`}},
+		{"{DELAY 500}x{DELAY 0}", []string{"sleep 500ms", `type "x"`, "sleep 0s"}},
+		{"{VKEY 13}{VKEY 0x41}^{VKEY 9}", []string{`press "enter"`, `press "a"`, `press "ctrl+tab"`}},
+		{"^a^+{END}%{F4}", []string{`press "ctrl+a"`, `press "ctrl+shift+end"`, `press "alt+f4"`}},
+		{"+(ab{TAB})c", []string{`down "shift"`, `type "ab"`, `press "tab"`, `up "shift"`, `type "c"`}},
+		{"{+}{^}{%}{~}{(}{)}{{}{}}", []string{`type "+^%~(){}"`}},
+		{"{x 3}{ }^{+}", []string{`type "xxx "`, `press "ctrl++"`}},
+		{"{s:card number}", []string{"type {S:CARD NUMBER}"}},
+		{"ünï", []string{`type "ünï"`}},
+	}
+	for _, tc := range tests {
+		a, err := ParseAutoType(tc.seq)
+		if err != nil {
+			t.Errorf("ParseAutoType(%q): %v", tc.seq, err)
+			continue
+		}
+		plan, err := a.Plan(fields)
+		if err != nil {
+			t.Errorf("Plan(%q): %v", tc.seq, err)
+			continue
+		}
+		var got []string
+		for _, act := range plan {
+			got = append(got, act.String())
+		}
+		if !slices.Equal(got, tc.want) {
+			t.Errorf("ParseAutoType(%q) plan\n got %q\nwant %q", tc.seq, got, tc.want)
+		}
+	}
+}
+
+// TestParseAutoTypeErrors checks that malformed sequences are rejected.
+func TestParseAutoTypeErrors(t *testing.T) {
+	for _, seq := range []string{
+		"{TAB", "abc}{", "{}", "a)b", "+(ab", "(ab)", "^", "x%",
+		"{TAB x}", "{TAB 5000}", "{DELAY -1}", "{DELAY x}",
+		"{VKEY 999}", "{VKEY}x{VKEY zz}", "^{PASSWORD}", "^é",
+	} {
+		if _, err := ParseAutoType(seq); !errors.Is(err, ErrInvalidSequence) {
+			t.Errorf("ParseAutoType(%q) = %v, want ErrInvalidSequence", seq, err)
+		}
+	}
+
+	a, err := ParseAutoType("{USERNAME}{NOPE}")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := a.Plan(map[string]string{"username": "x"}); !errors.Is(err, ErrUnknownPlaceholder) {
+		t.Fatalf("Plan with a missing field = %v", err)
+	}
+}
+
+// TestRunAutoType runs a login sequence against the Recorder and checks
+// that field values are typed verbatim, never parsed.
+func TestRunAutoType(t *testing.T) {
+	rec := useRecorder(t)
+	fields := map[string]string{"username": "alice", "password": "p{TAB}+x"}
+
+	err := RunAutoType("{USERNAME}{TAB}{PASSWORD}{DELAY 1}+(ok){ENTER}", fields)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []Event{
+		{Kind: EventType, Value: "alice"},
+		{Kind: EventKeyPress, Value: "tab"},
+		{Kind: EventType, Value: "p{TAB}+x"},
+		{Kind: EventKeyDown, Value: "shift"},
+		{Kind: EventType, Value: "ok"},
+		{Kind: EventKeyUp, Value: "shift"},
+		{Kind: EventKeyPress, Value: "enter"},
+	}
+	if got := rec.Events(); !slices.Equal(got, want) {
+		t.Fatalf("events\n got %v\nwant %v", got, want)
+	}
+
+	// A failure inside a group still releases the held modifiers
+	a, err := ParseAutoType("^+(x{FAIL})")
+	if err != nil {
+		t.Fatal(err)
+	}
+	boom := errors.New("boom")
+	failing := &errAfterRecorder{Recorder: NewRecorder(), after: 2, err: boom}
+	Register(failing, 300)
+	defer Unregister(failing.Name())
+	if err := a.Run(map[string]string{"fail": "y"}); !errors.Is(err, boom) {
+		t.Fatalf("Run = %v, want boom", err)
+	}
+	got := failing.Events()
+	if len(got) != 3 || got[2] != (Event{Kind: EventKeyUp, Value: "ctrl+shift"}) {
+		t.Fatalf("held keys not released: %v", got)
+	}
+}
+
+// errAfterRecorder fails Type once the given number of events is recorded
+type errAfterRecorder struct {
+	*Recorder
+	after int
+	err   error
+}
+
+func (r *errAfterRecorder) Name() string { return "failing" }
+
+func (r *errAfterRecorder) Type(text string, delay time.Duration) error {
+	if len(r.Events()) == r.after {
+		return r.err
+	}
+	return r.Recorder.Type(text, delay)
+}
+
+// TestDryRun checks the printed plan and that field values stay hidden.
+func TestDryRun(t *testing.T) {
+	a, err := ParseAutoType("{USERNAME}{TAB}{PASSWORD}{DELAY 250}{ENTER}")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var out strings.Builder
+	if err := a.DryRun(&out, map[string]string{"username": "alice", "password": "hunter2"}); err != nil {
+		t.Fatal(err)
+	}
+	want := "type {USERNAME}\npress \"tab\"\ntype {PASSWORD}\nsleep 250ms\npress \"enter\"\n"
+	if out.String() != want {
+		t.Fatalf("DryRun =\n%s\nwant\n%s", out.String(), want)
+	}
+	if strings.Contains(out.String(), "hunter2") {
+		t.Fatal("DryRun revealed a field value")
+	}
+}
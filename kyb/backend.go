@@ -0,0 +1,226 @@
+// backend.go - Part of the `kyb` Package
+//
+//     ॐ भूर्भुवः स्वः
+//     तत्स॑वि॒तुर्वरे॑ण्यं॒
+//    भर्गो॑ दे॒वस्य॑ धीमहि।
+//   धियो॒ यो नः॑ प्रचो॒दया॑त्॥
+//
+//
+//  बी.स.जी - बोसजी के द्वारा रचित सुरक्षा एवं गोपनीयता हेतु तन्त्राक्ष्।
+// ================================================
+//
+// एक सुरक्षा एवं गोपनीयता केंद्रित तंत्राक्षों का संकलन।
+//
+// एक रचनात्मक भारतीय उत्पाद ।
+//
+// bsg - Boseji's Security and Privacy Utilities
+//
+// A collection of Security and Privacy utilities and some notes for help.
+//
+// This is **Golang** package collection as well as few utility
+// command line programs.
+//
+// Sources
+// -------
+// https://github.com/boseji/bsg
+//
+// License
+// -------
+//
+//   bsg - Boseji's Security and Privacy Utilities.
+//   Copyright (C) 2025-2026 by Abhijit Bose (aka. Boseji)
+//
+//   This program is free software: you can redistribute it and/or modify
+//   it under the terms of the GNU General Public License version 2 only
+//   as published by the Free Software Foundation.
+//
+//   This program is distributed in the hope that it will be useful,
+//   but WITHOUT ANY WARRANTY; without even the implied warranty of
+//   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+//
+//   You should have received a copy of the GNU General Public License
+//   along with this program. If not, see <https://www.gnu.org/licenses/>.
+//
+//  SPDX-License-Identifier: GPL-2.0-only
+//  Full Name: GNU General Public License v2.0 only
+//  Please visit <https://spdx.org/licenses/GPL-2.0-only.html> for details.
+//
+
+package kyb
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Backend produces keyboard events one way, e.g. through xdotool or the
+// Win32 SendInput API. Keys are names such as "enter" or "a", or chords
+// such as "ctrl+c"; KeyDown presses the keys of a chord in order and
+// KeyUp releases them in reverse.
+type Backend interface {
+	// Name identifies the backend for SetBackend and KYB_BACKEND
+	Name() string
+	// Available reports whether the backend can be used right now
+	Available() bool
+	// Type types text, pausing delay between keystrokes; kyb.Type passes
+	// the SetDelay value, TypeWithOptions its own
+	Type(text string, delay time.Duration) error
+	KeyPress(key string) error
+	KeyDown(key string) error
+	KeyUp(key string) error
+}
+
+// BackendEnv is the environment variable that forces a backend by name,
+// e.g. KYB_BACKEND=ydotool. SetBackend takes precedence over it.
+const BackendEnv = "KYB_BACKEND"
+
+// registration is a backend added through Register
+type registration struct {
+	backend  Backend
+	priority int
+}
+
+var (
+	backendMu     sync.RWMutex
+	registered    []registration
+	forcedBackend string
+)
+
+// Register adds a backend to the registry. Backends with a higher
+// priority are tried first; the built-in ones have priority 0 and come
+// before others registered at the same priority. Registering a name
+// again replaces the earlier backend, including a built-in one.
+func Register(b Backend, priority int) {
+	if b == nil {
+		panic("kyb: Register backend is nil")
+	}
+	name := strings.ToLower(b.Name())
+	if name == "" {
+		panic("kyb: Register backend without a name")
+	}
+
+	backendMu.Lock()
+	defer backendMu.Unlock()
+	registered = slices.DeleteFunc(registered, func(r registration) bool {
+		return strings.ToLower(r.backend.Name()) == name
+	})
+	registered = append(registered, registration{b, priority})
+}
+
+// Unregister removes a backend added through Register. Built-in backends
+// replaced under the same name come back.
+func Unregister(name string) {
+	name = strings.ToLower(name)
+
+	backendMu.Lock()
+	defer backendMu.Unlock()
+	registered = slices.DeleteFunc(registered, func(r registration) bool {
+		return strings.ToLower(r.backend.Name()) == name
+	})
+}
+
+// Backends lists the registered backends in the order they are tried
+func Backends() []Backend {
+	backendMu.RLock()
+	defer backendMu.RUnlock()
+	return backends()
+}
+
+// backends merges the built-in and registered backends. The caller holds
+// backendMu.
+func backends() []Backend {
+	all := make([]registration, 0, len(registered)+4)
+	for _, b := range platformBackends() {
+		if !slices.ContainsFunc(registered, func(r registration) bool {
+			return strings.EqualFold(r.backend.Name(), b.Name())
+		}) {
+			all = append(all, registration{b, 0})
+		}
+	}
+	all = append(all, registered...)
+	slices.SortStableFunc(all, func(a, b registration) int {
+		return b.priority - a.priority
+	})
+
+	list := make([]Backend, len(all))
+	for i, r := range all {
+		list[i] = r.backend
+	}
+	return list
+}
+
+// Lookup finds a registered backend by name, ignoring case
+func Lookup(name string) (Backend, bool) {
+	for _, b := range Backends() {
+		if strings.EqualFold(b.Name(), name) {
+			return b, true
+		}
+	}
+	return nil, false
+}
+
+// Active returns the backend Type and KeyPress use right now: the forced
+// one if SetBackend or KYB_BACKEND name one, otherwise the first
+// available in priority order. A forced backend that is unavailable is
+// an error rather than a silent fallback.
+func Active() (Backend, error) {
+	if name := getBackend(); name != "" {
+		b, ok := Lookup(name)
+		if !ok {
+			return nil, fmt.Errorf("%w: unknown backend %q", ErrNotSupported, name)
+		}
+		if !b.Available() {
+			return nil, fmt.Errorf("%w: backend %q is not available", ErrNotSupported, name)
+		}
+		return b, nil
+	}
+
+	for _, b := range Backends() {
+		if b.Available() {
+			return b, nil
+		}
+	}
+	return nil, ErrNotSupported
+}
+
+// CurrentBackend names the backend Type and KeyPress would use right now,
+// or "" if none is available
+func CurrentBackend() string {
+	b, err := Active()
+	if err != nil {
+		return ""
+	}
+	return b.Name()
+}
+
+// SetBackend forces the named backend instead of the automatic choice.
+// An empty name goes back to automatic selection.
+func SetBackend(name string) error {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if name != "" {
+		if _, ok := Lookup(name); !ok {
+			return fmt.Errorf("%w: unknown backend %q", ErrNotSupported, name)
+		}
+	}
+
+	backendMu.Lock()
+	forcedBackend = name
+	backendMu.Unlock()
+	return nil
+}
+
+// getBackend returns the forced backend name, "" for automatic selection
+func getBackend() string {
+	backendMu.RLock()
+	name := forcedBackend
+	backendMu.RUnlock()
+
+	if name == "" {
+		name = strings.ToLower(strings.TrimSpace(os.Getenv(BackendEnv)))
+	}
+	return name
+}
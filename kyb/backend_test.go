@@ -0,0 +1,195 @@
+// backend_test.go - Test Program `kyb` Package
+//
+//     ॐ भूर्भुवः स्वः
+//     तत्स॑वि॒तुर्वरे॑ण्यं॒
+//    भर्गो॑ दे॒वस्य॑ धीमहि।
+//   धियो॒ यो नः॑ प्रचो॒दया॑त्॥
+//
+//
+//  बी.स.जी - बोसजी के द्वारा रचित सुरक्षा एवं गोपनीयता हेतु तन्त्राक्ष्।
+// ================================================
+//
+// एक सुरक्षा एवं गोपनीयता केंद्रित तंत्राक्षों का संकलन।
+//
+// एक रचनात्मक भारतीय उत्पाद ।
+//
+// bsg - Boseji's Security and Privacy Utilities
+//
+// A collection of Security and Privacy utilities and some notes for help.
+//
+// This is **Golang** package collection as well as few utility
+// command line programs.
+//
+// Sources
+// -------
+// https://github.com/boseji/bsg
+//
+// License
+// -------
+//
+//   bsg - Boseji's Security and Privacy Utilities.
+//   Copyright (C) 2025-2026 by Abhijit Bose (aka. Boseji)
+//
+//   This program is free software: you can redistribute it and/or modify
+//   it under the terms of the GNU General Public License version 2 only
+//   as published by the Free Software Foundation.
+//
+//   This program is distributed in the hope that it will be useful,
+//   but WITHOUT ANY WARRANTY; without even the implied warranty of
+//   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+//
+//   You should have received a copy of the GNU General Public License
+//   along with this program. If not, see <https://www.gnu.org/licenses/>.
+//
+//  SPDX-License-Identifier: GPL-2.0-only
+//  Full Name: GNU General Public License v2.0 only
+//  Please visit <https://spdx.org/licenses/GPL-2.0-only.html> for details.
+//
+
+package kyb
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	"time"
+)
+
+// namedRecorder gives a Recorder another name so several can be
+// registered side by side
+type namedRecorder struct {
+	*Recorder
+	name string
+}
+
+func (n namedRecorder) Name() string { return n.name }
+
+// useRecorder registers a fresh Recorder ahead of every built-in backend
+// and removes it when the test ends
+func useRecorder(t *testing.T) *Recorder {
+	t.Helper()
+	t.Setenv(BackendEnv, "")
+	rec := NewRecorder()
+	Register(rec, 100)
+	t.Cleanup(func() {
+		Unregister(rec.Name())
+		SetBackend("")
+	})
+	return rec
+}
+
+// TestRecorder checks that the package functions reach the registered
+// Recorder and that it captures the exact call sequence.
+func TestRecorder(t *testing.T) {
+	rec := useRecorder(t)
+	if got := CurrentBackend(); got != "recorder" {
+		t.Fatalf("CurrentBackend() = %q", got)
+	}
+
+	SetDelay(20 * time.Millisecond)
+	defer SetDelay(0)
+	steps := []func() error{
+		func() error { return Type("user") },
+		func() error { return KeyPress("tab") },
+		func() error { return TypeFast("pa55") },
+		func() error { return KeyDown("ctrl+shift") },
+		func() error { return KeyPress("v") },
+		func() error { return KeyUp("ctrl+shift") },
+	}
+	for i, step := range steps {
+		if err := step(); err != nil {
+			t.Fatalf("step %d: %v", i, err)
+		}
+	}
+	if err := KeyPress("ctrl+nope"); !errors.Is(err, ErrUnknownKey) {
+		t.Fatalf("KeyPress(ctrl+nope) = %v", err)
+	}
+
+	want := []Event{
+		{Kind: EventType, Value: "user", Delay: 20 * time.Millisecond},
+		{Kind: EventKeyPress, Value: "tab"},
+		{Kind: EventType, Value: "pa55"},
+		{Kind: EventKeyDown, Value: "ctrl+shift"},
+		{Kind: EventKeyPress, Value: "v"},
+		{Kind: EventKeyUp, Value: "ctrl+shift"},
+	}
+	if got := rec.Events(); !slices.Equal(got, want) {
+		t.Fatalf("events = %v\nwant %v", got, want)
+	}
+
+	boom := errors.New("boom")
+	rec.SetError(boom)
+	if err := Type("x"); !errors.Is(err, boom) {
+		t.Fatalf("Type with SetError = %v", err)
+	}
+	rec.SetError(nil)
+	rec.Reset()
+	if len(rec.Events()) != 0 {
+		t.Fatal("Reset kept events")
+	}
+}
+
+// TestRegistry checks priority ordering, replacement, forcing by name and
+// skipping of unavailable backends.
+func TestRegistry(t *testing.T) {
+	rec := useRecorder(t)
+	high := namedRecorder{NewRecorder(), "high"}
+	low := namedRecorder{NewRecorder(), "Low"}
+	Register(low, -5)
+	Register(high, 200)
+	defer Unregister("high")
+	defer Unregister("low")
+
+	names := func() []string {
+		var list []string
+		for _, b := range Backends() {
+			list = append(list, b.Name())
+		}
+		return list
+	}
+	got := names()
+	if got[0] != "high" || got[1] != "recorder" || got[len(got)-1] != "Low" {
+		t.Fatalf("Backends() order = %v", got)
+	}
+	if _, ok := Lookup("LOW"); !ok {
+		t.Fatal("Lookup is not case-insensitive")
+	}
+	if CurrentBackend() != "high" {
+		t.Fatalf("CurrentBackend() = %q, want high", CurrentBackend())
+	}
+
+	high.SetAvailable(false)
+	if CurrentBackend() != "recorder" {
+		t.Fatalf("unavailable backend chosen: %q", CurrentBackend())
+	}
+
+	if err := SetBackend("low"); err != nil {
+		t.Fatal(err)
+	}
+	if err := Type("forced"); err != nil {
+		t.Fatal(err)
+	}
+	if len(low.Events()) != 1 || len(rec.Events()) != 0 {
+		t.Fatalf("forced backend not used: low %v, recorder %v", low.Events(), rec.Events())
+	}
+	if err := SetBackend("high"); err != nil {
+		t.Fatal(err)
+	}
+	if err := Type("x"); !errors.Is(err, ErrNotSupported) {
+		t.Fatalf("unavailable forced backend = %v", err)
+	}
+	if err := SetBackend("nope"); !errors.Is(err, ErrNotSupported) {
+		t.Fatalf("SetBackend(nope) = %v", err)
+	}
+
+	// Registering a name again replaces the backend
+	SetBackend("")
+	again := namedRecorder{NewRecorder(), "HIGH"}
+	Register(again, 300)
+	if b, _ := Lookup("high"); b != Backend(again) {
+		t.Fatal("Register did not replace the backend of the same name")
+	}
+	if n := len(Backends()); n != len(got) {
+		t.Fatalf("Backends() has %d entries after replacing, want %d", n, len(got))
+	}
+}
@@ -0,0 +1,289 @@
+// keys.go - Part of the `kyb` Package
+//
+//     ॐ भूर्भुवः स्वः
+//     तत्स॑वि॒तुर्वरे॑ण्यं॒
+//    भर्गो॑ दे॒वस्य॑ धीमहि।
+//   धियो॒ यो नः॑ प्रचो॒दया॑त्॥
+//
+//
+//  बी.स.जी - बोसजी के द्वारा रचित सुरक्षा एवं गोपनीयता हेतु तन्त्राक्ष्।
+// ================================================
+//
+// एक सुरक्षा एवं गोपनीयता केंद्रित तंत्राक्षों का संकलन।
+//
+// एक रचनात्मक भारतीय उत्पाद ।
+//
+// bsg - Boseji's Security and Privacy Utilities
+//
+// A collection of Security and Privacy utilities and some notes for help.
+//
+// This is **Golang** package collection as well as few utility
+// command line programs.
+//
+// Sources
+// -------
+// https://github.com/boseji/bsg
+//
+// License
+// -------
+//
+//   bsg - Boseji's Security and Privacy Utilities.
+//   Copyright (C) 2025-2026 by Abhijit Bose (aka. Boseji)
+//
+//   This program is free software: you can redistribute it and/or modify
+//   it under the terms of the GNU General Public License version 2 only
+//   as published by the Free Software Foundation.
+//
+//   This program is distributed in the hope that it will be useful,
+//   but WITHOUT ANY WARRANTY; without even the implied warranty of
+//   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+//
+//   You should have received a copy of the GNU General Public License
+//   along with this program. If not, see <https://www.gnu.org/licenses/>.
+//
+//  SPDX-License-Identifier: GPL-2.0-only
+//  Full Name: GNU General Public License v2.0 only
+//  Please visit <https://spdx.org/licenses/GPL-2.0-only.html> for details.
+//
+
+package kyb
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"unicode/utf8"
+)
+
+// keyDef describes one key in the form each backend needs. Character
+// keys are given for a US keyboard layout.
+type keyDef struct {
+	name  string // canonical kyb name, or the character itself
+	xkb   string // X keysym name, used by xdotool and wtype
+	evdev uint16 // Linux input event code, used by ydotool and uinput
+	vk    uint16 // Windows virtual key code
+	mac   uint16 // macOS virtual key code
+	shift bool   // Shift must be held for it on a US layout
+	mod   string // wtype modifier name, set for modifier keys only
+	char  rune   // printable character, 0 for named keys
+}
+
+// Codes of the modifiers used while typing
+const (
+	evdevLeftShift = 42
+	vkShift        = 0x10
+)
+
+// namedKeys maps the canonical key names to their definitions
+var namedKeys = map[string]keyDef{}
+
+// keyAliases maps other common spellings to canonical names. X keysym
+// names such as "Return" or "BackSpace" work as well, case-insensitively.
+var keyAliases = map[string]string{
+	"return": "enter", "esc": "escape", "bs": "backspace", "bksp": "backspace",
+	"del": "delete", "ins": "insert", "pgup": "pageup", "prior": "pageup",
+	"page_up": "pageup", "pgdn": "pagedown", "next": "pagedown",
+	"page_down": "pagedown", "caps_lock": "capslock", "control": "ctrl",
+	"option": "alt", "win": "super", "meta": "super", "cmd": "super",
+	"command": "super",
+}
+
+// usLayout maps the printable ASCII characters, newline and tab to their
+// keys on a US keyboard
+var usLayout = map[rune]keyDef{}
+
+// punctKeysyms names the X keysyms of the ASCII punctuation characters
+var punctKeysyms = map[rune]string{
+	'-': "minus", '=': "equal", '[': "bracketleft", ']': "bracketright",
+	';': "semicolon", '\'': "apostrophe", '`': "grave", '\\': "backslash",
+	',': "comma", '.': "period", '/': "slash", '!': "exclam", '@': "at",
+	'#': "numbersign", '$': "dollar", '%': "percent", '^': "asciicircum",
+	'&': "ampersand", '*': "asterisk", '(': "parenleft", ')': "parenright",
+	'_': "underscore", '+': "plus", '{': "braceleft", '}': "braceright",
+	':': "colon", '"': "quotedbl", '~': "asciitilde", '|': "bar",
+	'<': "less", '>': "greater", '?': "question",
+}
+
+func init() {
+	named := []keyDef{
+		{name: "enter", xkb: "Return", evdev: 28, vk: 0x0d, mac: 36},
+		{name: "tab", xkb: "Tab", evdev: 15, vk: 0x09, mac: 48},
+		{name: "escape", xkb: "Escape", evdev: 1, vk: 0x1b, mac: 53},
+		{name: "space", xkb: "space", evdev: 57, vk: 0x20, mac: 49},
+		{name: "backspace", xkb: "BackSpace", evdev: 14, vk: 0x08, mac: 51},
+		{name: "delete", xkb: "Delete", evdev: 111, vk: 0x2e, mac: 117},
+		{name: "insert", xkb: "Insert", evdev: 110, vk: 0x2d, mac: 114},
+		{name: "home", xkb: "Home", evdev: 102, vk: 0x24, mac: 115},
+		{name: "end", xkb: "End", evdev: 107, vk: 0x23, mac: 119},
+		{name: "pageup", xkb: "Prior", evdev: 104, vk: 0x21, mac: 116},
+		{name: "pagedown", xkb: "Next", evdev: 109, vk: 0x22, mac: 121},
+		{name: "up", xkb: "Up", evdev: 103, vk: 0x26, mac: 126},
+		{name: "down", xkb: "Down", evdev: 108, vk: 0x28, mac: 125},
+		{name: "left", xkb: "Left", evdev: 105, vk: 0x25, mac: 123},
+		{name: "right", xkb: "Right", evdev: 106, vk: 0x27, mac: 124},
+		{name: "capslock", xkb: "Caps_Lock", evdev: 58, vk: 0x14, mac: 57},
+		{name: "shift", xkb: "Shift_L", evdev: evdevLeftShift, vk: vkShift, mac: 56, mod: "shift"},
+		{name: "ctrl", xkb: "Control_L", evdev: 29, vk: 0x11, mac: 59, mod: "ctrl"},
+		{name: "alt", xkb: "Alt_L", evdev: 56, vk: 0x12, mac: 58, mod: "alt"},
+		{name: "altgr", xkb: "ISO_Level3_Shift", evdev: 100, vk: 0xa5, mac: 61, mod: "altgr"},
+		{name: "super", xkb: "Super_L", evdev: 125, vk: 0x5b, mac: 55, mod: "logo"},
+	}
+	// F1 to F12; the evdev and macOS codes are not contiguous
+	fEvdev := []uint16{59, 60, 61, 62, 63, 64, 65, 66, 67, 68, 87, 88}
+	fMac := []uint16{122, 120, 99, 118, 96, 97, 98, 100, 101, 109, 103, 111}
+	for i := range fEvdev {
+		n := fmt.Sprint(i + 1)
+		named = append(named, keyDef{name: "f" + n, xkb: "F" + n,
+			evdev: fEvdev[i], vk: uint16(0x70 + i), mac: fMac[i]})
+	}
+	for _, k := range named {
+		namedKeys[k.name] = k
+		keyAliases[strings.ToLower(k.xkb)] = k.name
+	}
+
+	// Each row lists the characters of one keyboard row with the codes of
+	// their keys; the shifted characters use the same keys with Shift.
+	rows := []struct {
+		plain, shifted string
+		evdev, vk, mac []uint16
+	}{
+		{"1234567890-=", "!@#$%^&*()_+",
+			[]uint16{2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13},
+			[]uint16{'1', '2', '3', '4', '5', '6', '7', '8', '9', '0', 0xbd, 0xbb},
+			[]uint16{18, 19, 20, 21, 23, 22, 26, 28, 25, 29, 27, 24}},
+		{"qwertyuiop[]", "QWERTYUIOP{}",
+			[]uint16{16, 17, 18, 19, 20, 21, 22, 23, 24, 25, 26, 27},
+			[]uint16{'Q', 'W', 'E', 'R', 'T', 'Y', 'U', 'I', 'O', 'P', 0xdb, 0xdd},
+			[]uint16{12, 13, 14, 15, 17, 16, 32, 34, 31, 35, 33, 30}},
+		{"asdfghjkl;'`", "ASDFGHJKL:\"~",
+			[]uint16{30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 40, 41},
+			[]uint16{'A', 'S', 'D', 'F', 'G', 'H', 'J', 'K', 'L', 0xba, 0xde, 0xc0},
+			[]uint16{0, 1, 2, 3, 5, 4, 38, 40, 37, 41, 39, 50}},
+		{"\\zxcvbnm,./", "|ZXCVBNM<>?",
+			[]uint16{43, 44, 45, 46, 47, 48, 49, 50, 51, 52, 53},
+			[]uint16{0xdc, 'Z', 'X', 'C', 'V', 'B', 'N', 'M', 0xbc, 0xbe, 0xbf},
+			[]uint16{42, 6, 7, 8, 9, 11, 45, 46, 43, 47, 44}},
+	}
+	for _, row := range rows {
+		for i := range row.evdev {
+			p, s := rune(row.plain[i]), rune(row.shifted[i])
+			k := keyDef{evdev: row.evdev[i], vk: row.vk[i], mac: row.mac[i]}
+			usLayout[p] = keyDef{name: string(p), xkb: keysym(p), evdev: k.evdev, vk: k.vk, mac: k.mac, char: p}
+			usLayout[s] = keyDef{name: string(s), xkb: keysym(s), evdev: k.evdev, vk: k.vk, mac: k.mac, shift: true, char: s}
+		}
+	}
+	usLayout[' '] = namedKeys["space"]
+	usLayout['\n'] = namedKeys["enter"]
+	usLayout['\t'] = namedKeys["tab"]
+}
+
+// keysym returns the X keysym name of a printable ASCII character
+func keysym(r rune) string {
+	if name, ok := punctKeysyms[r]; ok {
+		return name
+	}
+	return string(r)
+}
+
+// lookupKey finds a key by name. Single characters are looked up on the
+// US layout as given, longer names and aliases case-insensitively.
+func lookupKey(name string) (keyDef, error) {
+	if utf8.RuneCountInString(name) == 1 {
+		r, _ := utf8.DecodeRuneInString(name)
+		if k, ok := usLayout[r]; ok {
+			return k, nil
+		}
+	}
+	lower := strings.ToLower(name)
+	if alias, ok := keyAliases[lower]; ok {
+		lower = alias
+	}
+	if k, ok := namedKeys[lower]; ok {
+		return k, nil
+	}
+	return keyDef{}, fmt.Errorf("%w: %q", ErrUnknownKey, name)
+}
+
+// lookupVK finds the key of a Windows virtual key code, preferring the
+// unshifted character for letters, digits and punctuation
+func lookupVK(vk uint16) (keyDef, bool) {
+	for _, k := range namedKeys {
+		if k.vk == vk {
+			return k, true
+		}
+	}
+	for _, k := range usLayout {
+		if k.vk == vk && !k.shift {
+			return k, true
+		}
+	}
+	return keyDef{}, false
+}
+
+// parseChord splits a key such as "ctrl+shift+v" into its modifiers and
+// the final key. A trailing "++" means the plus key itself.
+func parseChord(chord string) (mods []keyDef, key keyDef, err error) {
+	parts := strings.Split(chord, "+")
+	if n := len(parts); n > 1 && parts[n-1] == "" && parts[n-2] == "" {
+		parts = append(parts[:n-2], "+")
+	}
+
+	for _, name := range parts[:len(parts)-1] {
+		k, err := lookupKey(strings.TrimSpace(name))
+		if err != nil {
+			return nil, keyDef{}, err
+		}
+		if k.mod == "" {
+			return nil, keyDef{}, fmt.Errorf("%w: %q is not a modifier", ErrUnknownKey, name)
+		}
+		mods = append(mods, k)
+	}
+
+	name := parts[len(parts)-1]
+	if name != " " {
+		name = strings.TrimSpace(name)
+	}
+	key, err = lookupKey(name)
+	if err != nil {
+		return nil, keyDef{}, err
+	}
+	return mods, key, nil
+}
+
+// chordKeys returns the keys of a chord in the order KeyDown presses
+// them, or reversed for KeyUp. Shift is added in front of a key that
+// needs it on a US layout unless the chord already holds it.
+func chordKeys(chord string, down bool) ([]keyDef, error) {
+	mods, k, err := parseChord(chord)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := mods
+	if k.shift && !slices.ContainsFunc(mods, func(m keyDef) bool { return m.mod == "shift" }) {
+		keys = append(keys, namedKeys["shift"])
+	}
+	keys = append(keys, k)
+	if !down {
+		slices.Reverse(keys)
+	}
+	return keys, nil
+}
+
+// ValidateKey checks a key name or chord such as "ctrl+shift+v" and
+// returns ErrUnknownKey for names no backend knows
+func ValidateKey(key string) error {
+	_, _, err := parseChord(key)
+	return err
+}
+
+// KeyNames lists the canonical names of the special keys, sorted. Single
+// characters of a US keyboard are valid key names as well.
+func KeyNames() []string {
+	names := make([]string, 0, len(namedKeys))
+	for name := range namedKeys {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
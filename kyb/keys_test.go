@@ -0,0 +1,154 @@
+// keys_test.go - Test Program `kyb` Package
+//
+//     ॐ भूर्भुवः स्वः
+//     तत्स॑वि॒तुर्वरे॑ण्यं॒
+//    भर्गो॑ दे॒वस्य॑ धीमहि।
+//   धियो॒ यो नः॑ प्रचो॒दया॑त्॥
+//
+//
+//  बी.स.जी - बोसजी के द्वारा रचित सुरक्षा एवं गोपनीयता हेतु तन्त्राक्ष्।
+// ================================================
+//
+// एक सुरक्षा एवं गोपनीयता केंद्रित तंत्राक्षों का संकलन।
+//
+// एक रचनात्मक भारतीय उत्पाद ।
+//
+// bsg - Boseji's Security and Privacy Utilities
+//
+// A collection of Security and Privacy utilities and some notes for help.
+//
+// This is **Golang** package collection as well as few utility
+// command line programs.
+//
+// Sources
+// -------
+// https://github.com/boseji/bsg
+//
+// License
+// -------
+//
+//   bsg - Boseji's Security and Privacy Utilities.
+//   Copyright (C) 2025-2026 by Abhijit Bose (aka. Boseji)
+//
+//   This program is free software: you can redistribute it and/or modify
+//   it under the terms of the GNU General Public License version 2 only
+//   as published by the Free Software Foundation.
+//
+//   This program is distributed in the hope that it will be useful,
+//   but WITHOUT ANY WARRANTY; without even the implied warranty of
+//   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+//
+//   You should have received a copy of the GNU General Public License
+//   along with this program. If not, see <https://www.gnu.org/licenses/>.
+//
+//  SPDX-License-Identifier: GPL-2.0-only
+//  Full Name: GNU General Public License v2.0 only
+//  Please visit <https://spdx.org/licenses/GPL-2.0-only.html> for details.
+//
+
+package kyb
+
+import (
+	"errors"
+	"testing"
+)
+
+// TestParseChord checks modifier splitting, the plus key and rejection
+// of unknown names.
+func TestParseChord(t *testing.T) {
+	tests := []struct {
+		chord string
+		mods  []string
+		key   string
+		shift bool
+		err   error
+	}{
+		{chord: "a", key: "a"},
+		{chord: "A", key: "A", shift: true},
+		{chord: "Enter", key: "Return"},
+		{chord: "cmd+shift+Tab", mods: []string{"logo", "shift"}, key: "Tab"},
+		{chord: "ctrl+shift+v", mods: []string{"ctrl", "shift"}, key: "v"},
+		{chord: "super+l", mods: []string{"logo"}, key: "l"},
+		{chord: "ctrl++", mods: []string{"ctrl"}, key: "plus", shift: true},
+		{chord: "+", key: "plus", shift: true},
+		{chord: "ctrl + c", mods: []string{"ctrl"}, key: "c"},
+		{chord: "a+b", err: ErrUnknownKey},
+		{chord: "hyper+x", err: ErrUnknownKey},
+		{chord: "ctrl+", err: ErrUnknownKey},
+		{chord: "é", err: ErrUnknownKey},
+	}
+	for _, tc := range tests {
+		mods, key, err := parseChord(tc.chord)
+		if tc.err != nil {
+			if !errors.Is(err, tc.err) {
+				t.Errorf("parseChord(%q) error = %v, want %v", tc.chord, err, tc.err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("parseChord(%q): %v", tc.chord, err)
+			continue
+		}
+		if key.xkb != tc.key || key.shift != tc.shift || len(mods) != len(tc.mods) {
+			t.Errorf("parseChord(%q) = %v, %+v", tc.chord, mods, key)
+			continue
+		}
+		for i, m := range mods {
+			if m.mod != tc.mods[i] {
+				t.Errorf("parseChord(%q) modifier %d = %q, want %q", tc.chord, i, m.mod, tc.mods[i])
+			}
+		}
+	}
+}
+
+// TestKeyTable checks the codes of a few keys on every platform and the
+// virtual key lookup used by {VKEY n}.
+func TestKeyTable(t *testing.T) {
+	tests := []struct {
+		name           string
+		canonical, xkb string
+		evdev, vk, mac uint16
+		shift          bool
+	}{
+		{"Return", "enter", "Return", 28, 0x0d, 36, false},
+		{"ESC", "escape", "Escape", 1, 0x1b, 53, false},
+		{"cmd", "super", "Super_L", 125, 0x5b, 55, false},
+		{"f11", "f11", "F11", 87, 0x7a, 103, false},
+		{"a", "a", "a", 30, 'A', 0, false},
+		{"Z", "Z", "Z", 44, 'Z', 6, true},
+		{"?", "?", "question", 53, 0xbf, 44, true},
+		{"5", "5", "5", 6, '5', 23, false},
+	}
+	for _, tc := range tests {
+		k, err := lookupKey(tc.name)
+		if err != nil {
+			t.Errorf("lookupKey(%q): %v", tc.name, err)
+			continue
+		}
+		if k.name != tc.canonical || k.xkb != tc.xkb || k.evdev != tc.evdev ||
+			k.vk != tc.vk || k.mac != tc.mac || k.shift != tc.shift {
+			t.Errorf("lookupKey(%q) = %+v", tc.name, k)
+		}
+	}
+
+	for vk, want := range map[uint16]string{0x0d: "enter", 0x41: "a", 0x31: "1", 0xbd: "-", 0x10: "shift"} {
+		k, ok := lookupVK(vk)
+		if !ok || k.name != want {
+			t.Errorf("lookupVK(%#x) = %q, %v, want %q", vk, k.name, ok, want)
+		}
+	}
+	if _, ok := lookupVK(0xff); ok {
+		t.Error("lookupVK(0xff) found a key")
+	}
+
+	if err := ValidateKey("ctrl+alt+delete"); err != nil {
+		t.Errorf("ValidateKey: %v", err)
+	}
+	if err := ValidateKey("ctrl+bogus"); !errors.Is(err, ErrUnknownKey) {
+		t.Errorf("ValidateKey(ctrl+bogus) = %v", err)
+	}
+	names := KeyNames()
+	if len(names) != 33 || names[0] != "alt" {
+		t.Errorf("KeyNames() = %v", names)
+	}
+}
@@ -51,6 +51,7 @@
 package kyb
 
 import (
+	"context"
 	"errors"
 	"sync"
 	"time"
@@ -58,6 +59,13 @@ import (
 
 var ErrNotSupported = errors.New("keyboard simulation not supported")
 
+// ErrUnknownKey is returned for key names no backend understands
+var ErrUnknownKey = errors.New("unknown key")
+
+// ErrInputBlocked is returned when the OS refused some of the events,
+// e.g. input towards an elevated window on Windows
+var ErrInputBlocked = errors.New("keyboard input was blocked")
+
 var (
 	delayMu  sync.RWMutex
 	keyDelay time.Duration
@@ -79,31 +87,47 @@ func getDelay() time.Duration {
 
 // Type types a full string
 func Type(text string) error {
-	return typeText(text)
+	b, err := Active()
+	if err != nil {
+		return err
+	}
+	return b.Type(text, getDelay())
 }
 
-// TypeFast disables delay temporarily
+// TypeFast types a full string without any delay between keystrokes
 func TypeFast(text string) error {
-	delayMu.Lock()
-	old := keyDelay
-	keyDelay = 0
-	delayMu.Unlock()
-
-	err := typeText(text)
-
-	delayMu.Lock()
-	keyDelay = old
-	delayMu.Unlock()
-
-	return err
+	return TypeWithOptions(context.Background(), text, WithDelay(0))
 }
 
 // KeyPress presses and releases a key (e.g. "ctrl+c", "alt+tab", "a")
 func KeyPress(key string) error {
-	return keyPress(key)
+	b, err := Active()
+	if err != nil {
+		return err
+	}
+	return b.KeyPress(key)
+}
+
+// KeyDown presses a key, or the keys of a chord, and keeps it held
+func KeyDown(key string) error {
+	b, err := Active()
+	if err != nil {
+		return err
+	}
+	return b.KeyDown(key)
+}
+
+// KeyUp releases a key, or the keys of a chord, held by KeyDown
+func KeyUp(key string) error {
+	b, err := Active()
+	if err != nil {
+		return err
+	}
+	return b.KeyUp(key)
 }
 
 // Available checks if backend dependencies exist
 func Available() bool {
-	return available()
+	_, err := Active()
+	return err == nil
 }
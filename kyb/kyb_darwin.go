@@ -52,58 +52,68 @@ package kyb
 
 import (
 	"os/exec"
+	"strings"
 	"time"
 )
 
+// osascriptBackend drives System Events through AppleScript
+type osascriptBackend struct{}
+
+func (osascriptBackend) Name() string              { return "osascript" }
+func (osascriptBackend) Available() bool           { return available() }
+func (osascriptBackend) KeyPress(key string) error { return keyPress(key) }
+func (osascriptBackend) KeyDown(key string) error  { return keyToggle(key, true) }
+func (osascriptBackend) KeyUp(key string) error    { return keyToggle(key, false) }
+
+func (osascriptBackend) Type(text string, delay time.Duration) error {
+	return typeText(text, delay)
+}
+
+func platformBackends() []Backend {
+	return []Backend{osascriptBackend{}}
+}
+
 func available() bool {
 	_, err := exec.LookPath("osascript")
 	return err == nil
 }
 
-func typeText(text string) error {
+// runScript runs an AppleScript and returns its trimmed output. The
+// script goes to osascript over stdin rather than with -e, keeping typed
+// text out of the process list.
+func runScript(script string) (string, error) {
 	if !available() {
-		return ErrNotSupported
+		return "", ErrNotSupported
 	}
+	cmd := exec.Command("osascript")
+	cmd.Stdin = strings.NewReader(script)
+	out, err := cmd.Output()
+	return strings.TrimRight(string(out), "\n"), err
+}
 
-	delay := getDelay()
-
-	for _, r := range text {
-		script := `tell application "System Events" to keystroke "` + string(r) + `"`
-		if err := exec.Command("osascript", "-e", script).Run(); err != nil {
-			return err
-		}
-
-		if delay > 0 {
-			time.Sleep(delay)
-		}
+func typeText(text string, delay time.Duration) error {
+	script, err := typeScript(text, delay)
+	if err != nil || script == "" {
+		return err
 	}
-	return nil
+	_, err = runScript(script)
+	return err
 }
 
 func keyPress(key string) error {
-	if !available() {
-		return ErrNotSupported
+	script, err := keyPressScript(key)
+	if err != nil {
+		return err
 	}
-
-	script := `tell application "System Events" to key code ` + macKeyCode(key)
-	return exec.Command("osascript", "-e", script).Run()
-}
-
-func quote(s string) string {
-	return `"` + s + `"`
+	_, err = runScript(script)
+	return err
 }
 
-func macKeyCode(key string) string {
-	switch key {
-	case "enter":
-		return "36"
-	case "tab":
-		return "48"
-	case "esc":
-		return "53"
-	case "space":
-		return "49"
-	default:
-		return "0"
+func keyToggle(key string, down bool) error {
+	script, err := keyToggleScript(key, down)
+	if err != nil {
+		return err
 	}
+	_, err = runScript(script)
+	return err
 }
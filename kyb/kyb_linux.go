@@ -51,46 +51,113 @@
 package kyb
 
 import (
+	"os"
 	"os/exec"
 	"strconv"
+	"strings"
+	"time"
 )
 
-func available() bool {
-	_, err := exec.LookPath("xdotool")
-	return err == nil
+// linuxBackend is one way of producing key events on Linux
+type linuxBackend struct {
+	name      string
+	available func() bool
+	typeText  func(text string, delay time.Duration) error
+	keyPress  func(key string) error
+	keyToggle func(key string, down bool) error
+}
+
+func (b linuxBackend) Name() string              { return b.name }
+func (b linuxBackend) Available() bool           { return b.available() }
+func (b linuxBackend) KeyPress(key string) error { return b.keyPress(key) }
+func (b linuxBackend) KeyDown(key string) error  { return b.keyToggle(key, true) }
+func (b linuxBackend) KeyUp(key string) error    { return b.keyToggle(key, false) }
+
+func (b linuxBackend) Type(text string, delay time.Duration) error {
+	return b.typeText(text, delay)
 }
 
-func typeText(text string) error {
-	if !available() {
-		return ErrNotSupported
+// platformBackends returns the backends in order of preference for the
+// current session. Wayland compositors ignore xdotool for native windows,
+// so it only comes last there, behind the uinput based backends that work
+// everywhere.
+func platformBackends() []Backend {
+	xdotool := linuxBackend{"xdotool", xdotoolAvailable, xdotoolType, xdotoolKey, xdotoolToggle}
+	wtype := linuxBackend{"wtype", wtypeAvailable, wtypeType, wtypeKey, wtypeToggle}
+	ydotool := linuxBackend{"ydotool", ydotoolAvailable, ydotoolType, ydotoolKey, ydotoolToggle}
+	uinput := linuxBackend{"uinput", uinputAvailable, uinputType, uinputKey, uinputToggle}
+
+	if waylandSession() {
+		return []Backend{wtype, ydotool, uinput, xdotool}
 	}
+	return []Backend{xdotool, ydotool, uinput, wtype}
+}
+
+// waylandSession reports whether we run under Wayland. XDG_SESSION_TYPE
+// is trusted when set, WAYLAND_DISPLAY is the fallback.
+func waylandSession() bool {
+	switch os.Getenv("XDG_SESSION_TYPE") {
+	case "wayland":
+		return true
+	case "x11", "tty":
+		return false
+	}
+	return os.Getenv("WAYLAND_DISPLAY") != ""
+}
+
+func xdotoolAvailable() bool {
+	_, err := exec.LookPath("xdotool")
+	return err == nil
+}
 
+func xdotoolType(text string, delay time.Duration) error {
 	args := []string{"type"}
 
-	if d := getDelay(); d > 0 {
-		args = append(args, "--delay", strconv.Itoa(int(d.Milliseconds())))
+	if delay > 0 {
+		args = append(args, "--delay", strconv.Itoa(int(delay.Milliseconds())))
 	}
 
-	args = append(args, text)
+	// The text goes over stdin: arguments are visible to every local
+	// user through ps and /proc/<pid>/cmdline.
+	args = append(args, "--file", "-")
 
-	return exec.Command(
-		"xdotool", args...,
-	).Run()
+	cmd := exec.Command("xdotool", args...)
+	cmd.Stdin = strings.NewReader(text)
+	return cmd.Run()
 }
 
-func keyPress(key string) error {
-	if !available() {
-		return ErrNotSupported
+func xdotoolKey(key string) error {
+	mods, k, err := parseChord(key)
+	if err != nil {
+		return err
 	}
 
-	// Enter key Fix
-	if key == "Enter" || key == "enter" || key == "return" {
-		key = "Return"
+	// xdotool takes chords as keysyms joined by "+"
+	names := make([]string, 0, len(mods)+1)
+	for _, m := range mods {
+		names = append(names, m.xkb)
 	}
+	names = append(names, k.xkb)
 
 	return exec.Command(
 		"xdotool",
 		"key",
-		key,
+		strings.Join(names, "+"),
 	).Run()
 }
+
+func xdotoolToggle(key string, down bool) error {
+	keys, err := chordKeys(key, down)
+	if err != nil {
+		return err
+	}
+
+	args := []string{"keyup"}
+	if down {
+		args[0] = "keydown"
+	}
+	for _, k := range keys {
+		args = append(args, k.xkb)
+	}
+	return exec.Command("xdotool", args...).Run()
+}
@@ -0,0 +1,381 @@
+//go:build linux
+
+// kyb_linux_test.go - Test Program `kyb` Package for Linux Implementation
+//
+//     ॐ भूर्भुवः स्वः
+//     तत्स॑वि॒तुर्वरे॑ण्यं॒
+//    भर्गो॑ दे॒वस्य॑ धीमहि।
+//   धियो॒ यो नः॑ प्रचो॒दया॑त्॥
+//
+//
+//  बी.स.जी - बोसजी के द्वारा रचित सुरक्षा एवं गोपनीयता हेतु तन्त्राक्ष्।
+// ================================================
+//
+// एक सुरक्षा एवं गोपनीयता केंद्रित तंत्राक्षों का संकलन।
+//
+// एक रचनात्मक भारतीय उत्पाद ।
+//
+// bsg - Boseji's Security and Privacy Utilities
+//
+// A collection of Security and Privacy utilities and some notes for help.
+//
+// This is **Golang** package collection as well as few utility
+// command line programs.
+//
+// Sources
+// -------
+// https://github.com/boseji/bsg
+//
+// License
+// -------
+//
+//   bsg - Boseji's Security and Privacy Utilities.
+//   Copyright (C) 2025-2026 by Abhijit Bose (aka. Boseji)
+//
+//   This program is free software: you can redistribute it and/or modify
+//   it under the terms of the GNU General Public License version 2 only
+//   as published by the Free Software Foundation.
+//
+//   This program is distributed in the hope that it will be useful,
+//   but WITHOUT ANY WARRANTY; without even the implied warranty of
+//   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+//
+//   You should have received a copy of the GNU General Public License
+//   along with this program. If not, see <https://www.gnu.org/licenses/>.
+//
+//  SPDX-License-Identifier: GPL-2.0-only
+//  Full Name: GNU General Public License v2.0 only
+//  Please visit <https://spdx.org/licenses/GPL-2.0-only.html> for details.
+//
+
+package kyb
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+)
+
+// catPath is looked up before any test narrows PATH to its stubs
+var catPath, _ = exec.LookPath("cat")
+
+// isolate hides every real backend: PATH only holds the returned stub
+// directory, session variables are cleared and uinput points nowhere.
+func isolate(t *testing.T) string {
+	t.Helper()
+	if _, err := os.Stat("/bin/sh"); err != nil {
+		t.Skip("no /bin/sh for the stub tools")
+	}
+	dir := t.TempDir()
+	for _, env := range []string{BackendEnv, "XDG_SESSION_TYPE", "WAYLAND_DISPLAY", "YDOTOOL_SOCKET", "XDG_RUNTIME_DIR"} {
+		t.Setenv(env, "")
+	}
+	old := uinputPath
+	uinputPath = filepath.Join(dir, "no-uinput")
+	t.Cleanup(func() { uinputPath = old })
+	t.Cleanup(func() { SetBackend("") })
+	return dir
+}
+
+// stubTool installs a fake command in the isolate directory that records
+// its arguments and stdin, and returns the paths of both records.
+func stubTool(t *testing.T, dir, name string) (argvFile, stdinFile string) {
+	t.Helper()
+	if catPath == "" {
+		t.Skip("no cat for the stub tools")
+	}
+	argvFile = filepath.Join(dir, name+".argv")
+	stdinFile = filepath.Join(dir, name+".stdin")
+	script := "#!/bin/sh\n" +
+		"printf '%s\\n' \"$@\" >> '" + argvFile + "'\n" +
+		"'" + catPath + "' >> '" + stdinFile + "'\n"
+	if err := os.WriteFile(filepath.Join(dir, name), []byte(script), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("PATH", dir)
+	return argvFile, stdinFile
+}
+
+func readRecord(t *testing.T, path string) string {
+	t.Helper()
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(b)
+}
+
+// TestTypeSecretNotInArgv guards against typed text leaking through the
+// process arguments, which any local user can read.
+func TestTypeSecretNotInArgv(t *testing.T) {
+	dir := isolate(t)
+	argvFile, stdinFile := stubTool(t, dir, "xdotool")
+
+	const secret = "hunter2-Pa55w0rd!"
+	SetDelay(0)
+	if err := Type(secret); err != nil {
+		t.Fatalf("Type: %v", err)
+	}
+	SetDelay(12 * time.Millisecond)
+	defer SetDelay(0)
+	if err := Type(secret); err != nil {
+		t.Fatalf("Type with delay: %v", err)
+	}
+
+	argv := readRecord(t, argvFile)
+	if strings.Contains(argv, secret) {
+		t.Fatalf("secret leaked into argv:\n%s", argv)
+	}
+	if !strings.Contains(argv, "--delay\n12\n") {
+		t.Errorf("delay not passed, argv:\n%s", argv)
+	}
+	if stdin := readRecord(t, stdinFile); stdin != secret+secret {
+		t.Fatalf("stdin = %q, want the secret twice", stdin)
+	}
+}
+
+// TestBackendSelection checks the automatic choice per session type and
+// the forced backend from SetBackend and the environment.
+func TestBackendSelection(t *testing.T) {
+	dir := isolate(t)
+	if Available() || CurrentBackend() != "" {
+		t.Fatalf("backend %q available with nothing installed", CurrentBackend())
+	}
+	if err := Type("x"); !errors.Is(err, ErrNotSupported) {
+		t.Fatalf("Type without backend = %v", err)
+	}
+
+	stubTool(t, dir, "xdotool")
+	stubTool(t, dir, "wtype")
+	stubTool(t, dir, "ydotool")
+	socket := filepath.Join(dir, "ydotool.sock")
+	if err := os.WriteFile(socket, nil, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	check := func(want string) {
+		t.Helper()
+		if got := CurrentBackend(); got != want {
+			t.Errorf("CurrentBackend() = %q, want %q", got, want)
+		}
+	}
+
+	check("xdotool")
+	t.Setenv("WAYLAND_DISPLAY", "wayland-1")
+	check("wtype")
+	t.Setenv("XDG_SESSION_TYPE", "x11")
+	check("xdotool")
+	t.Setenv("XDG_SESSION_TYPE", "wayland")
+	t.Setenv("WAYLAND_DISPLAY", "")
+	check("xdotool") // wtype needs a Wayland display, ydotool its daemon
+	t.Setenv("YDOTOOL_SOCKET", socket)
+	check("ydotool")
+
+	t.Setenv(BackendEnv, "xdotool")
+	check("xdotool")
+	if err := SetBackend("YDOTOOL"); err != nil {
+		t.Fatal(err)
+	}
+	check("ydotool")
+	if err := SetBackend("uinput"); err != nil {
+		t.Fatal(err)
+	}
+	check("") // forced but missing: no silent fallback
+	if err := Type("x"); !errors.Is(err, ErrNotSupported) {
+		t.Fatalf("Type with missing forced backend = %v", err)
+	}
+	if err := SetBackend("nope"); !errors.Is(err, ErrNotSupported) {
+		t.Fatalf("SetBackend(nope) = %v", err)
+	}
+}
+
+// TestWaylandTools checks that wtype and ydotool get the text over stdin
+// and chords in their own notation.
+func TestWaylandTools(t *testing.T) {
+	dir := isolate(t)
+	const secret = "s3cr3t Pa55!"
+	SetDelay(5 * time.Millisecond)
+	defer SetDelay(0)
+
+	wArgv, wStdin := stubTool(t, dir, "wtype")
+	t.Setenv("WAYLAND_DISPLAY", "wayland-1")
+	if err := Type(secret); err != nil {
+		t.Fatalf("wtype Type: %v", err)
+	}
+	if err := KeyPress("ctrl+shift+v"); err != nil {
+		t.Fatalf("wtype KeyPress: %v", err)
+	}
+	if err := KeyPress("Enter"); err != nil {
+		t.Fatalf("wtype KeyPress: %v", err)
+	}
+	if err := KeyPress("ctrl+-"); err != nil {
+		t.Fatalf("wtype KeyPress: %v", err)
+	}
+	want := "-d\n5\n-\n" +
+		"-M\nctrl\n-M\nshift\n-k\nv\n-m\nshift\n-m\nctrl\n" +
+		"-k\nReturn\n" +
+		"-M\nctrl\n-k\nminus\n-m\nctrl\n"
+	if got := readRecord(t, wArgv); got != want {
+		t.Errorf("wtype argv = %q, want %q", got, want)
+	}
+	if got := readRecord(t, wStdin); got != secret {
+		t.Errorf("wtype stdin = %q", got)
+	}
+
+	yArgv, yStdin := stubTool(t, dir, "ydotool")
+	t.Setenv("XDG_RUNTIME_DIR", dir)
+	if err := os.WriteFile(filepath.Join(dir, ".ydotool_socket"), nil, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := SetBackend("ydotool"); err != nil {
+		t.Fatal(err)
+	}
+	if err := Type(secret); err != nil {
+		t.Fatalf("ydotool Type: %v", err)
+	}
+	if err := KeyPress("alt+T"); err != nil {
+		t.Fatalf("ydotool KeyPress: %v", err)
+	}
+	if err := KeyPress("ctrl+nope"); !errors.Is(err, ErrUnknownKey) {
+		t.Errorf("unknown key = %v", err)
+	}
+	want = "type\n--key-delay\n5\n--file\n-\n" +
+		"key\n56:1\n42:1\n20:1\n20:0\n42:0\n56:0\n"
+	if got := readRecord(t, yArgv); got != want {
+		t.Errorf("ydotool argv = %q, want %q", got, want)
+	}
+	if got := readRecord(t, yStdin); got != secret {
+		t.Errorf("ydotool stdin = %q", got)
+	}
+}
+
+// TestUinputRefusesUnknownRunes checks that text the US layout cannot
+// produce is refused before any device is opened.
+func TestUinputRefusesUnknownRunes(t *testing.T) {
+	isolate(t)
+	if err := uinputType("naïve", 0); !errors.Is(err, ErrNotSupported) {
+		t.Fatalf("uinputType = %v", err)
+	}
+	if uinputAvailable() {
+		t.Fatal("uinput available at a missing path")
+	}
+}
+
+// TestKeyToggle checks that KeyDown presses a chord in order and KeyUp
+// releases it in reverse on each command line backend.
+func TestKeyToggle(t *testing.T) {
+	dir := isolate(t)
+	xArgv, _ := stubTool(t, dir, "xdotool")
+	wArgv, _ := stubTool(t, dir, "wtype")
+	yArgv, _ := stubTool(t, dir, "ydotool")
+	t.Setenv("WAYLAND_DISPLAY", "wayland-1")
+	t.Setenv("YDOTOOL_SOCKET", filepath.Join(dir, "ydotool.sock"))
+	if err := os.WriteFile(filepath.Join(dir, "ydotool.sock"), nil, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		backend, argv, want string
+	}{
+		{"xdotool", xArgv, "keydown\nControl_L\nShift_L\nQ\nkeyup\nQ\nShift_L\nControl_L\n"},
+		{"wtype", wArgv, "-M\nctrl\n-M\nshift\n-P\nQ\n-p\nQ\n-m\nshift\n-m\nctrl\n"},
+		{"ydotool", yArgv, "key\n29:1\n42:1\n16:1\nkey\n16:0\n42:0\n29:0\n"},
+	}
+	for _, tc := range tests {
+		if err := SetBackend(tc.backend); err != nil {
+			t.Fatal(err)
+		}
+		if err := KeyDown("ctrl+Q"); err != nil {
+			t.Fatalf("%s KeyDown: %v", tc.backend, err)
+		}
+		if err := KeyUp("ctrl+Q"); err != nil {
+			t.Fatalf("%s KeyUp: %v", tc.backend, err)
+		}
+		if err := KeyDown("ctrl+bogus"); !errors.Is(err, ErrUnknownKey) {
+			t.Errorf("%s KeyDown unknown key = %v", tc.backend, err)
+		}
+		if got := readRecord(t, tc.argv); got != tc.want {
+			t.Errorf("%s argv = %q, want %q", tc.backend, got, tc.want)
+		}
+	}
+}
+
+// TestXdotoolKeys checks that key names and chords are normalised to
+// xdotool keysyms and unknown names never reach the tool.
+func TestXdotoolKeys(t *testing.T) {
+	dir := isolate(t)
+	argvFile, _ := stubTool(t, dir, "xdotool")
+
+	for _, key := range []string{"Enter", "return", "ctrl+shift+v", "super+l", "alt+F4", "ctrl+-", "Page_Up"} {
+		if err := KeyPress(key); err != nil {
+			t.Fatalf("KeyPress(%q): %v", key, err)
+		}
+	}
+	for _, key := range []string{"enterr", "v+ctrl", "ctrl+"} {
+		if err := KeyPress(key); !errors.Is(err, ErrUnknownKey) {
+			t.Errorf("KeyPress(%q) = %v, want ErrUnknownKey", key, err)
+		}
+	}
+
+	want := "key\nReturn\nkey\nReturn\nkey\nControl_L+Shift_L+v\nkey\nSuper_L+l\n" +
+		"key\nAlt_L+F4\nkey\nControl_L+minus\nkey\nPrior\n"
+	if got := readRecord(t, argvFile); got != want {
+		t.Errorf("xdotool argv = %q, want %q", got, want)
+	}
+}
+
+// TestXdotoolWindows drives window targeting through a fake xdotool that
+// knows two windows and keeps the active one in a file.
+func TestXdotoolWindows(t *testing.T) {
+	dir := isolate(t)
+	if catPath == "" {
+		t.Skip("no cat for the stub tools")
+	}
+	active := filepath.Join(dir, "active")
+	if err := os.WriteFile(active, []byte("111\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	script := `#!/bin/sh
+case "$1" in
+search) printf '111\n222\n' ;;
+getwindowname) [ "$2" = 111 ] && echo "Team Chat" || echo "Login - Browser" ;;
+getwindowclassname) [ "$2" = 111 ] && echo chat || echo browser ;;
+getwindowpid) echo 42 ;;
+getactivewindow) '` + catPath + `' '` + active + `' ;;
+windowactivate) echo "$2" > '` + active + `' ;;
+*) exit 1 ;;
+esac
+`
+	if err := os.WriteFile(filepath.Join(dir, "xdotool"), []byte(script), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("PATH", dir)
+
+	found, err := FindWindows(WindowMatch{Class: regexp.MustCompile(`^browser$`)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := Window{ID: "222", Title: "Login - Browser", Class: "browser", PID: 42}
+	if len(found) != 1 || found[0] != want {
+		t.Fatalf("FindWindows = %+v", found)
+	}
+
+	target, err := Focus(WindowMatch{Title: regexp.MustCompile(`Login`)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := target.Verify(); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(active, []byte("111\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := target.Verify(); !errors.Is(err, ErrFocusChanged) {
+		t.Fatalf("Verify after focus change = %v", err)
+	}
+}
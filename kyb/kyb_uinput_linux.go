@@ -0,0 +1,267 @@
+//go:build linux
+
+// kyb_uinput_linux.go - Part of the `kyb` Package for Linux Implementation
+//
+//     ॐ भूर्भुवः स्वः
+//     तत्स॑वि॒तुर्वरे॑ण्यं॒
+//    भर्गो॑ दे॒वस्य॑ धीमहि।
+//   धियो॒ यो नः॑ प्रचो॒दया॑त्॥
+//
+//
+//  बी.स.जी - बोसजी के द्वारा रचित सुरक्षा एवं गोपनीयता हेतु तन्त्राक्ष्।
+// ================================================
+//
+// एक सुरक्षा एवं गोपनीयता केंद्रित तंत्राक्षों का संकलन।
+//
+// एक रचनात्मक भारतीय उत्पाद ।
+//
+// bsg - Boseji's Security and Privacy Utilities
+//
+// A collection of Security and Privacy utilities and some notes for help.
+//
+// This is **Golang** package collection as well as few utility
+// command line programs.
+//
+// Sources
+// -------
+// https://github.com/boseji/bsg
+//
+// License
+// -------
+//
+//   bsg - Boseji's Security and Privacy Utilities.
+//   Copyright (C) 2025-2026 by Abhijit Bose (aka. Boseji)
+//
+//   This program is free software: you can redistribute it and/or modify
+//   it under the terms of the GNU General Public License version 2 only
+//   as published by the Free Software Foundation.
+//
+//   This program is distributed in the hope that it will be useful,
+//   but WITHOUT ANY WARRANTY; without even the implied warranty of
+//   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+//
+//   You should have received a copy of the GNU General Public License
+//   along with this program. If not, see <https://www.gnu.org/licenses/>.
+//
+//  SPDX-License-Identifier: GPL-2.0-only
+//  Full Name: GNU General Public License v2.0 only
+//  Please visit <https://spdx.org/licenses/GPL-2.0-only.html> for details.
+//
+
+package kyb
+
+import (
+	"fmt"
+	"os"
+	"sync"
+	"syscall"
+	"time"
+	"unsafe"
+)
+
+// uinputPath is the kernel's virtual input device. Opening it usually
+// needs root or membership of the "input" group via a udev rule.
+var uinputPath = "/dev/uinput"
+
+// uinputSettle gives the compositor time to pick up a new device before
+// the first key is sent, otherwise the first events are lost
+const uinputSettle = 250 * time.Millisecond
+
+// Constants from linux/input-event-codes.h and linux/uinput.h
+const (
+	evSyn     = 0x00
+	evKey     = 0x01
+	synReport = 0
+	keyMax    = 248 // the last key code found on regular keyboards
+
+	uiDevCreate = 0x5501     // _IO('U', 1)
+	uiDevSetup  = 0x405c5503 // _IOW('U', 3, struct uinput_setup)
+	uiSetEvBit  = 0x40045564 // _IOW('U', 100, int)
+	uiSetKeyBit = 0x40045565 // _IOW('U', 101, int)
+
+	busVirtual = 0x06
+)
+
+// uinputSetup mirrors struct uinput_setup
+type uinputSetup struct {
+	Bustype      uint16
+	Vendor       uint16
+	Product      uint16
+	Version      uint16
+	Name         [80]byte
+	FFEffectsMax uint32
+}
+
+// inputEvent mirrors struct input_event, whose time field follows the
+// platform's word size just like syscall.Timeval
+type inputEvent struct {
+	Time  syscall.Timeval
+	Type  uint16
+	Code  uint16
+	Value int32
+}
+
+// uinputDev is the process wide virtual keyboard, created on first use
+// and kept open; the kernel removes it when the process exits. The lock
+// also keeps concurrent calls from interleaving their keys.
+var uinputDev struct {
+	sync.Mutex
+	f *os.File
+}
+
+func uinputAvailable() bool {
+	uinputDev.Lock()
+	defer uinputDev.Unlock()
+	if uinputDev.f != nil {
+		return true
+	}
+
+	f, err := os.OpenFile(uinputPath, os.O_WRONLY, 0)
+	if err != nil {
+		return false
+	}
+	f.Close()
+	return true
+}
+
+// uinputOpen creates the virtual keyboard. The caller holds uinputDev.
+func uinputOpen() (*os.File, error) {
+	if uinputDev.f != nil {
+		return uinputDev.f, nil
+	}
+
+	f, err := os.OpenFile(uinputPath, os.O_WRONLY, 0)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrNotSupported, err)
+	}
+
+	err = ioctl(f, uiSetEvBit, evKey)
+	for code := uintptr(1); err == nil && code <= keyMax; code++ {
+		err = ioctl(f, uiSetKeyBit, code)
+	}
+	if err == nil {
+		setup := uinputSetup{Bustype: busVirtual, Version: 1}
+		copy(setup.Name[:], "bsg kyb virtual keyboard")
+		err = ioctl(f, uiDevSetup, uintptr(unsafe.Pointer(&setup)))
+	}
+	if err == nil {
+		err = ioctl(f, uiDevCreate, 0)
+	}
+	if err != nil {
+		f.Close()
+		return nil, fmt.Errorf("uinput: %w", err)
+	}
+
+	time.Sleep(uinputSettle)
+	uinputDev.f = f
+	return f, nil
+}
+
+func ioctl(f *os.File, req, arg uintptr) error {
+	_, _, errno := syscall.Syscall(syscall.SYS_IOCTL, f.Fd(), req, arg)
+	if errno != 0 {
+		return errno
+	}
+	return nil
+}
+
+// uinputEmit writes one key event followed by a sync report
+func uinputEmit(f *os.File, code uint16, down bool) error {
+	events := [2]inputEvent{
+		{Type: evKey, Code: code},
+		{Type: evSyn, Code: synReport},
+	}
+	if down {
+		events[0].Value = 1
+	}
+	_, err := f.Write(unsafe.Slice((*byte)(unsafe.Pointer(&events)), unsafe.Sizeof(events)))
+	return err
+}
+
+// uinputTap presses the keys in order and releases them in reverse
+func uinputTap(f *os.File, keys []keyDef) error {
+	var err error
+	pressed := 0
+	for _, k := range keys {
+		if err = uinputEmit(f, k.evdev, true); err != nil {
+			break
+		}
+		pressed++
+	}
+	// Release what was pressed even after a failure so nothing stays stuck
+	for i := pressed - 1; i >= 0; i-- {
+		if e := uinputEmit(f, keys[i].evdev, false); err == nil {
+			err = e
+		}
+	}
+	return err
+}
+
+// uinputType sends raw key codes, so text is typed as if on a US layout
+// keyboard and characters outside it are refused up front
+func uinputType(text string, delay time.Duration) error {
+	taps := make([][]keyDef, 0, len(text))
+	for _, r := range text {
+		k, ok := usLayout[r]
+		if !ok {
+			return fmt.Errorf("%w: %q cannot be typed through uinput", ErrNotSupported, r)
+		}
+		if k.shift {
+			taps = append(taps, []keyDef{namedKeys["shift"], k})
+		} else {
+			taps = append(taps, []keyDef{k})
+		}
+	}
+
+	uinputDev.Lock()
+	defer uinputDev.Unlock()
+	f, err := uinputOpen()
+	if err != nil {
+		return err
+	}
+
+	for _, keys := range taps {
+		if err := uinputTap(f, keys); err != nil {
+			return err
+		}
+		if delay > 0 {
+			time.Sleep(delay)
+		}
+	}
+	return nil
+}
+
+func uinputKey(key string) error {
+	keys, err := chordKeys(key, true)
+	if err != nil {
+		return err
+	}
+
+	uinputDev.Lock()
+	defer uinputDev.Unlock()
+	f, err := uinputOpen()
+	if err != nil {
+		return err
+	}
+	return uinputTap(f, keys)
+}
+
+func uinputToggle(key string, down bool) error {
+	keys, err := chordKeys(key, down)
+	if err != nil {
+		return err
+	}
+
+	uinputDev.Lock()
+	defer uinputDev.Unlock()
+	f, err := uinputOpen()
+	if err != nil {
+		return err
+	}
+	for _, k := range keys {
+		if err := uinputEmit(f, k.evdev, down); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,201 @@
+//go:build linux
+
+// kyb_wayland_linux.go - Part of the `kyb` Package for Linux Implementation
+//
+//     ॐ भूर्भुवः स्वः
+//     तत्स॑वि॒तुर्वरे॑ण्यं॒
+//    भर्गो॑ दे॒वस्य॑ धीमहि।
+//   धियो॒ यो नः॑ प्रचो॒दया॑त्॥
+//
+//
+//  बी.स.जी - बोसजी के द्वारा रचित सुरक्षा एवं गोपनीयता हेतु तन्त्राक्ष्।
+// ================================================
+//
+// एक सुरक्षा एवं गोपनीयता केंद्रित तंत्राक्षों का संकलन।
+//
+// एक रचनात्मक भारतीय उत्पाद ।
+//
+// bsg - Boseji's Security and Privacy Utilities
+//
+// A collection of Security and Privacy utilities and some notes for help.
+//
+// This is **Golang** package collection as well as few utility
+// command line programs.
+//
+// Sources
+// -------
+// https://github.com/boseji/bsg
+//
+// License
+// -------
+//
+//   bsg - Boseji's Security and Privacy Utilities.
+//   Copyright (C) 2025-2026 by Abhijit Bose (aka. Boseji)
+//
+//   This program is free software: you can redistribute it and/or modify
+//   it under the terms of the GNU General Public License version 2 only
+//   as published by the Free Software Foundation.
+//
+//   This program is distributed in the hope that it will be useful,
+//   but WITHOUT ANY WARRANTY; without even the implied warranty of
+//   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+//
+//   You should have received a copy of the GNU General Public License
+//   along with this program. If not, see <https://www.gnu.org/licenses/>.
+//
+//  SPDX-License-Identifier: GPL-2.0-only
+//  Full Name: GNU General Public License v2.0 only
+//  Please visit <https://spdx.org/licenses/GPL-2.0-only.html> for details.
+//
+
+package kyb
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// wtype speaks the virtual keyboard protocol of wlroots based compositors
+// such as Sway and Hyprland; GNOME and KDE do not implement it.
+func wtypeAvailable() bool {
+	if os.Getenv("WAYLAND_DISPLAY") == "" {
+		return false
+	}
+	_, err := exec.LookPath("wtype")
+	return err == nil
+}
+
+func wtypeType(text string, delay time.Duration) error {
+	var args []string
+
+	if delay > 0 {
+		args = append(args, "-d", strconv.Itoa(int(delay.Milliseconds())))
+	}
+
+	// "-" makes wtype read the text from stdin, keeping it out of argv
+	args = append(args, "-")
+
+	cmd := exec.Command("wtype", args...)
+	cmd.Stdin = strings.NewReader(text)
+	return cmd.Run()
+}
+
+func wtypeKey(key string) error {
+	mods, k, err := parseChord(key)
+	if err != nil {
+		return err
+	}
+
+	var args []string
+	for _, m := range mods {
+		args = append(args, "-M", m.mod)
+	}
+	// Always by keysym: a bare "-" would make wtype read stdin, and any
+	// text starting with "-" could be taken for an option.
+	args = append(args, "-k", k.xkb)
+	for i := len(mods) - 1; i >= 0; i-- {
+		args = append(args, "-m", mods[i].mod)
+	}
+
+	return exec.Command("wtype", args...).Run()
+}
+
+// ydotool needs its ydotoold daemon, which creates a uinput device and
+// works under X11, Wayland and even the console.
+func ydotoolAvailable() bool {
+	if _, err := exec.LookPath("ydotool"); err != nil {
+		return false
+	}
+	_, err := os.Stat(ydotoolSocket())
+	return err == nil
+}
+
+// ydotoolSocket finds the daemon socket the same way the ydotool client does
+func ydotoolSocket() string {
+	if s := os.Getenv("YDOTOOL_SOCKET"); s != "" {
+		return s
+	}
+	if dir := os.Getenv("XDG_RUNTIME_DIR"); dir != "" {
+		return filepath.Join(dir, ".ydotool_socket")
+	}
+	return "/tmp/.ydotool_socket"
+}
+
+func ydotoolType(text string, delay time.Duration) error {
+	args := []string{"type"}
+
+	if delay > 0 {
+		args = append(args, "--key-delay", strconv.Itoa(int(delay.Milliseconds())))
+	}
+
+	// The text goes over stdin, keeping it out of argv
+	args = append(args, "--file", "-")
+
+	cmd := exec.Command("ydotool", args...)
+	cmd.Stdin = strings.NewReader(text)
+	return cmd.Run()
+}
+
+func ydotoolKey(key string) error {
+	keys, err := chordKeys(key, true)
+	if err != nil {
+		return err
+	}
+
+	args := []string{"key"}
+	for _, k := range keys {
+		args = append(args, event(k.evdev, true))
+	}
+	for i := len(keys) - 1; i >= 0; i-- {
+		args = append(args, event(keys[i].evdev, false))
+	}
+	return exec.Command("ydotool", args...).Run()
+}
+
+func ydotoolToggle(key string, down bool) error {
+	keys, err := chordKeys(key, down)
+	if err != nil {
+		return err
+	}
+
+	args := []string{"key"}
+	for _, k := range keys {
+		args = append(args, event(k.evdev, down))
+	}
+	return exec.Command("ydotool", args...).Run()
+}
+
+// event formats a key event the way ydotool takes it: code:1 for down,
+// code:0 for up
+func event(code uint16, down bool) string {
+	if down {
+		return strconv.Itoa(int(code)) + ":1"
+	}
+	return strconv.Itoa(int(code)) + ":0"
+}
+
+func wtypeToggle(key string, down bool) error {
+	keys, err := chordKeys(key, down)
+	if err != nil {
+		return err
+	}
+
+	var args []string
+	for _, k := range keys {
+		switch {
+		case k.mod != "" && down:
+			args = append(args, "-M", k.mod)
+		case k.mod != "":
+			args = append(args, "-m", k.mod)
+		case down:
+			args = append(args, "-P", k.xkb)
+		default:
+			args = append(args, "-p", k.xkb)
+		}
+	}
+	return exec.Command("wtype", args...).Run()
+}
@@ -0,0 +1,139 @@
+//go:build darwin
+
+// kyb_window_darwin.go - Part of the `kyb` Package for MacOS Implementation
+//
+//     ॐ भूर्भुवः स्वः
+//     तत्स॑वि॒तुर्वरे॑ण्यं॒
+//    भर्गो॑ दे॒वस्य॑ धीमहि।
+//   धियो॒ यो नः॑ प्रचो॒दया॑त्॥
+//
+//
+//  बी.स.जी - बोसजी के द्वारा रचित सुरक्षा एवं गोपनीयता हेतु तन्त्राक्ष्।
+// ================================================
+//
+// एक सुरक्षा एवं गोपनीयता केंद्रित तंत्राक्षों का संकलन।
+//
+// एक रचनात्मक भारतीय उत्पाद ।
+//
+// bsg - Boseji's Security and Privacy Utilities
+//
+// A collection of Security and Privacy utilities and some notes for help.
+//
+// This is **Golang** package collection as well as few utility
+// command line programs.
+//
+// Sources
+// -------
+// https://github.com/boseji/bsg
+//
+// License
+// -------
+//
+//   bsg - Boseji's Security and Privacy Utilities.
+//   Copyright (C) 2025-2026 by Abhijit Bose (aka. Boseji)
+//
+//   This program is free software: you can redistribute it and/or modify
+//   it under the terms of the GNU General Public License version 2 only
+//   as published by the Free Software Foundation.
+//
+//   This program is distributed in the hope that it will be useful,
+//   but WITHOUT ANY WARRANTY; without even the implied warranty of
+//   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+//
+//   You should have received a copy of the GNU General Public License
+//   along with this program. If not, see <https://www.gnu.org/licenses/>.
+//
+//  SPDX-License-Identifier: GPL-2.0-only
+//  Full Name: GNU General Public License v2.0 only
+//  Please visit <https://spdx.org/licenses/GPL-2.0-only.html> for details.
+//
+
+package kyb
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// systemEventsWindows finds and raises windows through System Events.
+// macOS gives no stable window ids, so a window is identified by the
+// process owning it and focus checks compare the frontmost process.
+type systemEventsWindows struct{}
+
+func platformWindows() windowSystem {
+	return systemEventsWindows{}
+}
+
+// listScript prints one line per window: pid, application, title
+const listScript = `set out to ""
+tell application "System Events"
+	repeat with p in (every process whose background only is false)
+		set pid to unix id of p
+		set appName to name of p
+		repeat with w in (every window of p)
+			set out to out & pid & tab & appName & tab & (name of w) & linefeed
+		end repeat
+	end repeat
+end tell
+return out`
+
+// activeScript prints the frontmost process and its front window
+const activeScript = `tell application "System Events"
+	set p to first process whose frontmost is true
+	set t to ""
+	try
+		set t to name of front window of p
+	end try
+	return (unix id of p as text) & tab & name of p & tab & t
+end tell`
+
+// parseWindowLine reads a "pid, application, title" line
+func parseWindowLine(line string) (Window, bool) {
+	f := strings.SplitN(line, "\t", 3)
+	if len(f) != 3 {
+		return Window{}, false
+	}
+	pid, err := strconv.Atoi(f[0])
+	if err != nil {
+		return Window{}, false
+	}
+	return Window{ID: f[0], Title: f[2], Class: f[1], PID: pid}, true
+}
+
+func (systemEventsWindows) list(WindowMatch) ([]Window, error) {
+	out, err := runScript(listScript)
+	if err != nil {
+		return nil, err
+	}
+	var list []Window
+	for _, line := range strings.Split(out, "\n") {
+		if w, ok := parseWindowLine(line); ok {
+			list = append(list, w)
+		}
+	}
+	return list, nil
+}
+
+func (systemEventsWindows) active() (Window, error) {
+	out, err := runScript(activeScript)
+	if err != nil {
+		return Window{}, err
+	}
+	w, ok := parseWindowLine(out)
+	if !ok {
+		return Window{}, fmt.Errorf("kyb: unexpected System Events reply %q", out)
+	}
+	return w, nil
+}
+
+func (systemEventsWindows) activate(w Window) error {
+	script := tellSystemEvents(
+		"set p to first process whose unix id is "+strconv.Itoa(w.PID),
+		"set frontmost of p to true",
+		"try",
+		"\tperform action \"AXRaise\" of (first window of p whose name is "+appleScriptString(w.Title)+")",
+		"end try")
+	_, err := runScript(script)
+	return err
+}
@@ -0,0 +1,132 @@
+//go:build linux
+
+// kyb_window_linux.go - Part of the `kyb` Package for Linux Implementation
+//
+//     ॐ भूर्भुवः स्वः
+//     तत्स॑वि॒तुर्वरे॑ण्यं॒
+//    भर्गो॑ दे॒वस्य॑ धीमहि।
+//   धियो॒ यो नः॑ प्रचो॒दया॑त्॥
+//
+//
+//  बी.स.जी - बोसजी के द्वारा रचित सुरक्षा एवं गोपनीयता हेतु तन्त्राक्ष्।
+// ================================================
+//
+// एक सुरक्षा एवं गोपनीयता केंद्रित तंत्राक्षों का संकलन।
+//
+// एक रचनात्मक भारतीय उत्पाद ।
+//
+// bsg - Boseji's Security and Privacy Utilities
+//
+// A collection of Security and Privacy utilities and some notes for help.
+//
+// This is **Golang** package collection as well as few utility
+// command line programs.
+//
+// Sources
+// -------
+// https://github.com/boseji/bsg
+//
+// License
+// -------
+//
+//   bsg - Boseji's Security and Privacy Utilities.
+//   Copyright (C) 2025-2026 by Abhijit Bose (aka. Boseji)
+//
+//   This program is free software: you can redistribute it and/or modify
+//   it under the terms of the GNU General Public License version 2 only
+//   as published by the Free Software Foundation.
+//
+//   This program is distributed in the hope that it will be useful,
+//   but WITHOUT ANY WARRANTY; without even the implied warranty of
+//   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+//
+//   You should have received a copy of the GNU General Public License
+//   along with this program. If not, see <https://www.gnu.org/licenses/>.
+//
+//  SPDX-License-Identifier: GPL-2.0-only
+//  Full Name: GNU General Public License v2.0 only
+//  Please visit <https://spdx.org/licenses/GPL-2.0-only.html> for details.
+//
+
+package kyb
+
+import (
+	"errors"
+	"fmt"
+	"os/exec"
+	"strconv"
+	"strings"
+)
+
+// xdotoolWindows finds and activates X11 windows through xdotool. Wayland
+// has no common protocol for this, only windows running under XWayland
+// are visible there.
+type xdotoolWindows struct{}
+
+func platformWindows() windowSystem {
+	return xdotoolWindows{}
+}
+
+// xdotool runs one xdotool command and returns its trimmed output
+func xdotool(args ...string) (string, error) {
+	if !xdotoolAvailable() {
+		return "", fmt.Errorf("%w: window targeting needs xdotool", ErrNotSupported)
+	}
+	out, err := exec.Command("xdotool", args...).Output()
+	return strings.TrimSpace(string(out)), err
+}
+
+func (xdotoolWindows) list(m WindowMatch) ([]Window, error) {
+	// Search broadly and leave the matching to Go regular expressions,
+	// xdotool only knows POSIX ones
+	by := "--name"
+	if m.Title == nil {
+		by = "--class"
+	}
+	out, err := xdotool("search", "--onlyvisible", by, ".")
+	var exit *exec.ExitError
+	if errors.As(err, &exit) && out == "" {
+		return nil, nil // xdotool fails when nothing is found
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var list []Window
+	for _, id := range strings.Fields(out) {
+		w, err := xdotoolWindow(id)
+		if err != nil {
+			continue // the window closed meanwhile
+		}
+		list = append(list, w)
+	}
+	return list, nil
+}
+
+// xdotoolWindow reads the title, class and process of a window
+func xdotoolWindow(id string) (Window, error) {
+	title, err := xdotool("getwindowname", id)
+	if err != nil {
+		return Window{}, err
+	}
+	w := Window{ID: id, Title: title}
+	// Older xdotool releases lack getwindowclassname
+	w.Class, _ = xdotool("getwindowclassname", id)
+	if pid, err := xdotool("getwindowpid", id); err == nil {
+		w.PID, _ = strconv.Atoi(pid)
+	}
+	return w, nil
+}
+
+func (xdotoolWindows) active() (Window, error) {
+	id, err := xdotool("getactivewindow")
+	if err != nil {
+		return Window{}, err
+	}
+	return xdotoolWindow(id)
+}
+
+func (xdotoolWindows) activate(w Window) error {
+	_, err := xdotool("windowactivate", w.ID)
+	return err
+}
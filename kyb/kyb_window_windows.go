@@ -0,0 +1,169 @@
+//go:build windows
+
+// kyb_window_windows.go - Part of the `kyb` Package for Windows Implementation
+//
+//     ॐ भूर्भुवः स्वः
+//     तत्स॑वि॒तुर्वरे॑ण्यं॒
+//    भर्गो॑ दे॒वस्य॑ धीमहि।
+//   धियो॒ यो नः॑ प्रचो॒दया॑त्॥
+//
+//
+//  बी.स.जी - बोसजी के द्वारा रचित सुरक्षा एवं गोपनीयता हेतु तन्त्राक्ष्।
+// ================================================
+//
+// एक सुरक्षा एवं गोपनीयता केंद्रित तंत्राक्षों का संकलन।
+//
+// एक रचनात्मक भारतीय उत्पाद ।
+//
+// bsg - Boseji's Security and Privacy Utilities
+//
+// A collection of Security and Privacy utilities and some notes for help.
+//
+// This is **Golang** package collection as well as few utility
+// command line programs.
+//
+// Sources
+// -------
+// https://github.com/boseji/bsg
+//
+// License
+// -------
+//
+//   bsg - Boseji's Security and Privacy Utilities.
+//   Copyright (C) 2025-2026 by Abhijit Bose (aka. Boseji)
+//
+//   This program is free software: you can redistribute it and/or modify
+//   it under the terms of the GNU General Public License version 2 only
+//   as published by the Free Software Foundation.
+//
+//   This program is distributed in the hope that it will be useful,
+//   but WITHOUT ANY WARRANTY; without even the implied warranty of
+//   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+//
+//   You should have received a copy of the GNU General Public License
+//   along with this program. If not, see <https://www.gnu.org/licenses/>.
+//
+//  SPDX-License-Identifier: GPL-2.0-only
+//  Full Name: GNU General Public License v2.0 only
+//  Please visit <https://spdx.org/licenses/GPL-2.0-only.html> for details.
+//
+
+package kyb
+
+import (
+	"errors"
+	"fmt"
+	"runtime"
+	"strconv"
+	"sync"
+	"syscall"
+	"unsafe"
+)
+
+var (
+	kernel32             = syscall.NewLazyDLL("kernel32.dll")
+	getCurrentThreadId   = kernel32.NewProc("GetCurrentThreadId")
+	enumWindows          = user32.NewProc("EnumWindows")
+	getWindowTextW       = user32.NewProc("GetWindowTextW")
+	getWindowTextLengthW = user32.NewProc("GetWindowTextLengthW")
+	getClassNameW        = user32.NewProc("GetClassNameW")
+	isWindowVisible      = user32.NewProc("IsWindowVisible")
+	isIconic             = user32.NewProc("IsIconic")
+	showWindow           = user32.NewProc("ShowWindow")
+	setForegroundWindow  = user32.NewProc("SetForegroundWindow")
+	attachThreadInput    = user32.NewProc("AttachThreadInput")
+)
+
+const swRestore = 9
+
+// Callbacks can never be freed, so EnumWindows reuses a single one that
+// collects into enumFound under enumMu
+var (
+	enumMu    sync.Mutex
+	enumFound []uintptr
+	enumProc  = syscall.NewCallback(func(hwnd, _ uintptr) uintptr {
+		enumFound = append(enumFound, hwnd)
+		return 1 // continue
+	})
+)
+
+// win32Windows finds windows with EnumWindows and activates them with
+// SetForegroundWindow
+type win32Windows struct{}
+
+func platformWindows() windowSystem {
+	return win32Windows{}
+}
+
+// hwndWindow reads the title, class and process of a window
+func hwndWindow(hwnd uintptr) Window {
+	n, _, _ := getWindowTextLengthW.Call(hwnd)
+	title := make([]uint16, n+1)
+	getWindowTextW.Call(hwnd, uintptr(unsafe.Pointer(&title[0])), n+1)
+
+	class := make([]uint16, 256)
+	getClassNameW.Call(hwnd, uintptr(unsafe.Pointer(&class[0])), uintptr(len(class)))
+
+	var pid uint32
+	getWindowThreadProcessId.Call(hwnd, uintptr(unsafe.Pointer(&pid)))
+
+	return Window{
+		ID:    "0x" + strconv.FormatUint(uint64(hwnd), 16),
+		Title: syscall.UTF16ToString(title),
+		Class: syscall.UTF16ToString(class),
+		PID:   int(pid),
+	}
+}
+
+func (win32Windows) list(WindowMatch) ([]Window, error) {
+	enumMu.Lock()
+	enumFound = enumFound[:0]
+	enumWindows.Call(enumProc, 0)
+	hwnds := append([]uintptr(nil), enumFound...)
+	enumMu.Unlock()
+
+	var list []Window
+	for _, hwnd := range hwnds {
+		if visible, _, _ := isWindowVisible.Call(hwnd); visible == 0 {
+			continue
+		}
+		if w := hwndWindow(hwnd); w.Title != "" {
+			list = append(list, w)
+		}
+	}
+	return list, nil
+}
+
+func (win32Windows) active() (Window, error) {
+	hwnd, _, _ := getForegroundWindow.Call()
+	if hwnd == 0 {
+		return Window{}, errors.New("kyb: no foreground window")
+	}
+	return hwndWindow(hwnd), nil
+}
+
+func (win32Windows) activate(w Window) error {
+	hwnd, err := strconv.ParseUint(w.ID, 0, 64)
+	if err != nil {
+		return fmt.Errorf("%w: bad window id %q", ErrWindowNotFound, w.ID)
+	}
+
+	if iconic, _, _ := isIconic.Call(uintptr(hwnd)); iconic != 0 {
+		showWindow.Call(uintptr(hwnd), swRestore)
+	}
+
+	// Windows only lets the foreground thread hand over the focus, so
+	// share its input state while asking for it
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	fg, _, _ := getForegroundWindow.Call()
+	fgThread, _, _ := getWindowThreadProcessId.Call(fg, 0)
+	cur, _, _ := getCurrentThreadId.Call()
+	if fgThread != 0 && fgThread != cur {
+		attachThreadInput.Call(cur, fgThread, 1)
+		defer attachThreadInput.Call(cur, fgThread, 0)
+	}
+
+	setForegroundWindow.Call(uintptr(hwnd))
+	return nil
+}
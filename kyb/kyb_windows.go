@@ -51,20 +51,34 @@
 package kyb
 
 import (
+	"fmt"
+	"slices"
 	"syscall"
 	"time"
 	"unicode"
+	"unicode/utf16"
 	"unsafe"
 )
 
 var (
-	user32    = syscall.NewLazyDLL("user32.dll")
-	sendInput = user32.NewProc("SendInput")
+	user32                   = syscall.NewLazyDLL("user32.dll")
+	sendInput                = user32.NewProc("SendInput")
+	vkKeyScanEx              = user32.NewProc("VkKeyScanExW")
+	getKeyboardLayout        = user32.NewProc("GetKeyboardLayout")
+	getForegroundWindow      = user32.NewProc("GetForegroundWindow")
+	getWindowThreadProcessId = user32.NewProc("GetWindowThreadProcessId")
 )
 
 const (
-	INPUT_KEYBOARD  = 1
-	KEYEVENTF_KEYUP = 0x0002
+	INPUT_KEYBOARD        = 1
+	KEYEVENTF_EXTENDEDKEY = 0x0001
+	KEYEVENTF_KEYUP       = 0x0002
+	KEYEVENTF_UNICODE     = 0x0004
+
+	vkReturn  = 0x0d
+	vkTab     = 0x09
+	vkControl = 0x11
+	vkMenu    = 0x12
 )
 
 type KEYBDINPUT struct {
@@ -75,109 +89,209 @@ type KEYBDINPUT struct {
 	ExtraInfo uintptr
 }
 
+// INPUT mirrors the Win32 INPUT structure. Its union is sized by the
+// larger MOUSEINPUT, which the padding accounts for; SendInput rejects
+// every call whose size argument does not match.
 type INPUT struct {
 	Type uint32
 	Ki   KEYBDINPUT
+	_    [8]byte
+}
+
+// sendInputBackend drives the Win32 SendInput API. Text is sent as
+// Unicode characters, which works for any rune on any keyboard layout,
+// falling back to the key strokes of the foreground keyboard layout for
+// control characters that Unicode input cannot carry.
+type sendInputBackend struct{}
+
+func (sendInputBackend) Name() string { return "sendinput" }
+
+func (sendInputBackend) Available() bool           { return true } // user32 is always present
+func (sendInputBackend) KeyPress(key string) error { return keyPress(key) }
+func (sendInputBackend) KeyDown(key string) error  { return keyToggle(key, true) }
+func (sendInputBackend) KeyUp(key string) error    { return keyToggle(key, false) }
+
+func (sendInputBackend) Type(text string, delay time.Duration) error {
+	return typeText(text, delay)
 }
 
-func available() bool {
-	return true // user32 is always present
+func platformBackends() []Backend {
+	return []Backend{sendInputBackend{}}
+}
+
+// send hands all events to one SendInput call, so no other input can
+// interleave with them
+func send(inputs []INPUT) error {
+	if len(inputs) == 0 {
+		return nil
+	}
+
+	n, _, err := sendInput.Call(
+		uintptr(len(inputs)),
+		uintptr(unsafe.Pointer(&inputs[0])),
+		unsafe.Sizeof(inputs[0]),
+	)
+	if int(n) != len(inputs) {
+		// Blocked input, e.g. towards an elevated window, reports no error
+		return fmt.Errorf("%w: SendInput sent %d of %d events: %v", ErrInputBlocked, n, len(inputs), err)
+	}
+	return nil
 }
 
-func sendKey(vk uint16, up bool) {
-	flags := uint32(0)
+// vkInput is a key event for a virtual key code
+func vkInput(vk uint16, up bool) INPUT {
+	var flags uint32
 	if up {
 		flags = KEYEVENTF_KEYUP
 	}
+	switch vk {
+	case 0x21, 0x22, 0x23, 0x24, 0x25, 0x26, 0x27, 0x28, 0x2d, 0x2e, 0x5b, 0xa5:
+		// Navigation keys, Win and right Alt sit on the extended keypad
+		flags |= KEYEVENTF_EXTENDEDKEY
+	}
+	return INPUT{Type: INPUT_KEYBOARD, Ki: KEYBDINPUT{Vk: vk, Flags: flags}}
+}
 
-	input := INPUT{
-		Type: INPUT_KEYBOARD,
-		Ki: KEYBDINPUT{
-			Vk:    vk,
-			Flags: flags,
-		},
+// unicodeInputs are the events typing one rune as Unicode, two pairs
+// for runes outside the Basic Multilingual Plane
+func unicodeInputs(r rune) []INPUT {
+	units := utf16.AppendRune(nil, r)
+	inputs := make([]INPUT, 0, 2*len(units))
+	for _, u := range units {
+		inputs = append(inputs,
+			INPUT{Type: INPUT_KEYBOARD, Ki: KEYBDINPUT{Scan: u, Flags: KEYEVENTF_UNICODE}},
+			INPUT{Type: INPUT_KEYBOARD, Ki: KEYBDINPUT{Scan: u, Flags: KEYEVENTF_UNICODE | KEYEVENTF_KEYUP}},
+		)
 	}
+	return inputs
+}
 
-	sendInput.Call(
-		1,
-		uintptr(unsafe.Pointer(&input)),
-		unsafe.Sizeof(input),
-	)
+// foregroundLayout returns the keyboard layout of the window that will
+// receive the input
+func foregroundLayout() uintptr {
+	hwnd, _, _ := getForegroundWindow.Call()
+	tid, _, _ := getWindowThreadProcessId.Call(hwnd, 0)
+	hkl, _, _ := getKeyboardLayout.Call(tid)
+	return hkl
 }
 
-func typeText(text string) error {
-	delay := getDelay()
+// layoutInputs are the events typing a rune as key strokes on the given
+// layout, with Shift, Ctrl and Alt as VkKeyScanEx asks for them. It
+// returns nil when the layout has no key for the rune.
+func layoutInputs(r rune, hkl uintptr) []INPUT {
+	if r > 0xffff {
+		return nil
+	}
+	res, _, _ := vkKeyScanEx.Call(uintptr(r), hkl)
+	if int16(res) == -1 {
+		return nil
+	}
+	return keyScanInputs(uint16(res))
+}
 
-	for _, r := range text {
-		if unicode.IsUpper(r) {
-			sendKey(0x10, false) // Shift down
-		}
+// keyScanInputs are the events for a VkKeyScanEx result: the virtual key
+// in the low byte, pressed with the modifiers set in the high byte, which
+// are released in reverse
+func keyScanInputs(res uint16) []INPUT {
+	vk, state := res&0xff, res>>8
+	var mods []uint16
+	if state&1 != 0 {
+		mods = append(mods, vkShift)
+	}
+	if state&2 != 0 {
+		mods = append(mods, vkControl)
+	}
+	if state&4 != 0 {
+		mods = append(mods, vkMenu)
+	}
 
-		vk := charToVK(r)
-		sendKey(vk, false)
-		sendKey(vk, true)
+	inputs := make([]INPUT, 0, 2*len(mods)+2)
+	for _, m := range mods {
+		inputs = append(inputs, vkInput(m, false))
+	}
+	inputs = append(inputs, vkInput(vk, false), vkInput(vk, true))
+	for i := len(mods) - 1; i >= 0; i-- {
+		inputs = append(inputs, vkInput(mods[i], true))
+	}
+	return inputs
+}
 
-		if unicode.IsUpper(r) {
-			sendKey(0x10, true) // Shift up
+// runeInputs are the events typing one rune. Line breaks and tabs are
+// sent as their keys, since many programs ignore them as Unicode, and
+// so are other control characters that the layout hkl has a key for,
+// such as backspace and escape.
+func runeInputs(r rune, hkl uintptr) []INPUT {
+	switch r {
+	case '\n', '\r':
+		return []INPUT{vkInput(vkReturn, false), vkInput(vkReturn, true)}
+	case '\t':
+		return []INPUT{vkInput(vkTab, false), vkInput(vkTab, true)}
+	}
+	if unicode.IsControl(r) {
+		if inputs := layoutInputs(r, hkl); inputs != nil {
+			return inputs
 		}
+	}
+	return unicodeInputs(r)
+}
 
-		if delay > 0 {
-			time.Sleep(delay)
+// textInputs are the events typing text, one group per keystroke, with
+// a CRLF line break pressing Enter once
+func textInputs(text string, hkl uintptr) [][]INPUT {
+	var groups [][]INPUT
+	prev := rune(0)
+	for _, r := range text {
+		if r == '\n' && prev == '\r' {
+			prev = r
+			continue
 		}
+		prev = r
+		groups = append(groups, runeInputs(r, hkl))
 	}
-	return nil
+	return groups
 }
 
-func keyPress(key string) error {
-	vk := keyStringToVK(key)
-	if vk == 0 {
-		return ErrNotSupported
+func typeText(text string, delay time.Duration) error {
+	groups := textInputs(text, foregroundLayout())
+	if delay <= 0 {
+		return send(slices.Concat(groups...))
+	}
+	for _, g := range groups {
+		if err := send(g); err != nil {
+			return err
+		}
+		time.Sleep(delay)
 	}
-
-	sendKey(vk, false)
-	sendKey(vk, true)
 	return nil
 }
 
-func charToVK(r rune) uint16 {
-	if r >= 'a' && r <= 'z' {
-		return uint16(r - 'a' + 0x41)
+func keyPress(key string) error {
+	keys, err := chordKeys(key, true)
+	if err != nil {
+		return err
 	}
-	if r >= 'A' && r <= 'Z' {
-		return uint16(r - 'A' + 0x41)
+
+	inputs := make([]INPUT, 0, 2*len(keys))
+	for _, k := range keys {
+		inputs = append(inputs, vkInput(k.vk, false))
 	}
-	if r >= '0' && r <= '9' {
-		return uint16(r)
+	for i := len(keys) - 1; i >= 0; i-- {
+		inputs = append(inputs, vkInput(keys[i].vk, true))
 	}
+	return send(inputs)
+}
 
-	switch r {
-	case ' ':
-		return 0x20
-	case '\n':
-		return 0x0D
-	default:
-		return 0
+// keyToggle presses the keys of a chord in order, or releases them in
+// reverse
+func keyToggle(key string, down bool) error {
+	keys, err := chordKeys(key, down)
+	if err != nil {
+		return err
 	}
-}
 
-func keyStringToVK(key string) uint16 {
-	switch key {
-	case "enter":
-		return 0x0D
-	case "esc":
-		return 0x1B
-	case "tab":
-		return 0x09
-	case "ctrl":
-		return 0x11
-	case "alt":
-		return 0x12
-	case "shift":
-		return 0x10
-	default:
-		if len(key) == 1 {
-			return charToVK(rune(key[0]))
-		}
+	inputs := make([]INPUT, 0, len(keys))
+	for _, k := range keys {
+		inputs = append(inputs, vkInput(k.vk, !down))
 	}
-	return 0
+	return send(inputs)
 }
@@ -0,0 +1,148 @@
+//go:build windows
+
+// kyb_windows_test.go - Test Program `kyb` Package for Windows Implementation
+//
+//     ॐ भूर्भुवः स्वः
+//     तत्स॑वि॒तुर्वरे॑ण्यं॒
+//    भर्गो॑ दे॒वस्य॑ धीमहि।
+//   धियो॒ यो नः॑ प्रचो॒दया॑त्॥
+//
+//
+//  बी.स.जी - बोसजी के द्वारा रचित सुरक्षा एवं गोपनीयता हेतु तन्त्राक्ष्।
+// ================================================
+//
+// एक सुरक्षा एवं गोपनीयता केंद्रित तंत्राक्षों का संकलन।
+//
+// एक रचनात्मक भारतीय उत्पाद ।
+//
+// bsg - Boseji's Security and Privacy Utilities
+//
+// A collection of Security and Privacy utilities and some notes for help.
+//
+// This is **Golang** package collection as well as few utility
+// command line programs.
+//
+// Sources
+// -------
+// https://github.com/boseji/bsg
+//
+// License
+// -------
+//
+//   bsg - Boseji's Security and Privacy Utilities.
+//   Copyright (C) 2025-2026 by Abhijit Bose (aka. Boseji)
+//
+//   This program is free software: you can redistribute it and/or modify
+//   it under the terms of the GNU General Public License version 2 only
+//   as published by the Free Software Foundation.
+//
+//   This program is distributed in the hope that it will be useful,
+//   but WITHOUT ANY WARRANTY; without even the implied warranty of
+//   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+//
+//   You should have received a copy of the GNU General Public License
+//   along with this program. If not, see <https://www.gnu.org/licenses/>.
+//
+//  SPDX-License-Identifier: GPL-2.0-only
+//  Full Name: GNU General Public License v2.0 only
+//  Please visit <https://spdx.org/licenses/GPL-2.0-only.html> for details.
+//
+
+package kyb
+
+import (
+	"slices"
+	"testing"
+	"unsafe"
+)
+
+// key is a compact form of a keyboard INPUT for comparisons
+type key struct {
+	vk, scan uint16
+	flags    uint32
+}
+
+func keys(inputs []INPUT) []key {
+	var list []key
+	for _, in := range inputs {
+		if in.Type != INPUT_KEYBOARD {
+			return nil
+		}
+		list = append(list, key{in.Ki.Vk, in.Ki.Scan, in.Ki.Flags})
+	}
+	return list
+}
+
+// TestInputSize checks INPUT against the Win32 size, which SendInput
+// insists on: 40 bytes on 64-bit Windows and 28 on 32-bit.
+func TestInputSize(t *testing.T) {
+	want := uintptr(28)
+	if unsafe.Sizeof(uintptr(0)) == 8 {
+		want = 40
+	}
+	if got := unsafe.Sizeof(INPUT{}); got != want {
+		t.Fatalf("sizeof(INPUT) = %d, want %d", got, want)
+	}
+}
+
+// TestUnicodeInputs checks that runes outside the BMP are sent as a
+// surrogate pair, each unit pressed and released.
+func TestUnicodeInputs(t *testing.T) {
+	const down, up = KEYEVENTF_UNICODE, KEYEVENTF_UNICODE | KEYEVENTF_KEYUP
+	tests := []struct {
+		r    rune
+		want []key
+	}{
+		{'é', []key{{0, 0xe9, down}, {0, 0xe9, up}}},
+		{'😀', []key{{0, 0xd83d, down}, {0, 0xd83d, up}, {0, 0xde00, down}, {0, 0xde00, up}}},
+	}
+	for _, tc := range tests {
+		if got := keys(unicodeInputs(tc.r)); !slices.Equal(got, tc.want) {
+			t.Errorf("unicodeInputs(%q) = %v, want %v", tc.r, got, tc.want)
+		}
+	}
+}
+
+// TestTextInputs checks that CRLF presses Enter once while lone CR, LF
+// and tabs press their keys.
+func TestTextInputs(t *testing.T) {
+	enter := []key{{vkReturn, 0, 0}, {vkReturn, 0, KEYEVENTF_KEYUP}}
+	tab := []key{{vkTab, 0, 0}, {vkTab, 0, KEYEVENTF_KEYUP}}
+	a := keys(unicodeInputs('a'))
+
+	groups := textInputs("a\r\na\n\ra\t", 0)
+	want := [][]key{a, enter, a, enter, enter, a, tab}
+	if len(groups) != len(want) {
+		t.Fatalf("%d keystrokes, want %d", len(groups), len(want))
+	}
+	for i, g := range groups {
+		if got := keys(g); !slices.Equal(got, want[i]) {
+			t.Errorf("keystroke %d = %v, want %v", i, got, want[i])
+		}
+	}
+}
+
+// TestKeyScanInputs checks that layout modifiers wrap the key, pressed
+// in order and released in reverse.
+func TestKeyScanInputs(t *testing.T) {
+	const up = KEYEVENTF_KEYUP
+	tests := []struct {
+		res  uint16
+		want []key
+	}{
+		{'A', []key{{'A', 0, 0}, {'A', 0, up}}},
+		// Shift+2 for '@' on a US layout
+		{0x0100 | '2', []key{{vkShift, 0, 0}, {'2', 0, 0}, {'2', 0, up}, {vkShift, 0, up}}},
+		// AltGr as Ctrl+Alt, with Shift, e.g. on German layouts
+		{0x0700 | 'Q', []key{
+			{vkShift, 0, 0}, {vkControl, 0, 0}, {vkMenu, 0, 0},
+			{'Q', 0, 0}, {'Q', 0, up},
+			{vkMenu, 0, up}, {vkControl, 0, up}, {vkShift, 0, up},
+		}},
+	}
+	for _, tc := range tests {
+		if got := keys(keyScanInputs(tc.res)); !slices.Equal(got, tc.want) {
+			t.Errorf("keyScanInputs(%#04x) = %v, want %v", tc.res, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,199 @@
+// recorder.go - Part of the `kyb` Package
+//
+//     ॐ भूर्भुवः स्वः
+//     तत्स॑वि॒तुर्वरे॑ण्यं॒
+//    भर्गो॑ दे॒वस्य॑ धीमहि।
+//   धियो॒ यो नः॑ प्रचो॒दया॑त्॥
+//
+//
+//  बी.स.जी - बोसजी के द्वारा रचित सुरक्षा एवं गोपनीयता हेतु तन्त्राक्ष्।
+// ================================================
+//
+// एक सुरक्षा एवं गोपनीयता केंद्रित तंत्राक्षों का संकलन।
+//
+// एक रचनात्मक भारतीय उत्पाद ।
+//
+// bsg - Boseji's Security and Privacy Utilities
+//
+// A collection of Security and Privacy utilities and some notes for help.
+//
+// This is **Golang** package collection as well as few utility
+// command line programs.
+//
+// Sources
+// -------
+// https://github.com/boseji/bsg
+//
+// License
+// -------
+//
+//   bsg - Boseji's Security and Privacy Utilities.
+//   Copyright (C) 2025-2026 by Abhijit Bose (aka. Boseji)
+//
+//   This program is free software: you can redistribute it and/or modify
+//   it under the terms of the GNU General Public License version 2 only
+//   as published by the Free Software Foundation.
+//
+//   This program is distributed in the hope that it will be useful,
+//   but WITHOUT ANY WARRANTY; without even the implied warranty of
+//   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+//
+//   You should have received a copy of the GNU General Public License
+//   along with this program. If not, see <https://www.gnu.org/licenses/>.
+//
+//  SPDX-License-Identifier: GPL-2.0-only
+//  Full Name: GNU General Public License v2.0 only
+//  Please visit <https://spdx.org/licenses/GPL-2.0-only.html> for details.
+//
+
+package kyb
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+)
+
+// EventKind says which Backend call an Event records
+type EventKind int
+
+const (
+	EventType EventKind = iota
+	EventKeyPress
+	EventKeyDown
+	EventKeyUp
+	EventSleep // a pause in an auto-type plan, never recorded
+)
+
+func (k EventKind) String() string {
+	switch k {
+	case EventType:
+		return "type"
+	case EventKeyPress:
+		return "press"
+	case EventKeyDown:
+		return "down"
+	case EventKeyUp:
+		return "up"
+	case EventSleep:
+		return "sleep"
+	}
+	return fmt.Sprintf("EventKind(%d)", int(k))
+}
+
+// Event is one call captured by a Recorder
+type Event struct {
+	Kind  EventKind
+	Value string        // the text for EventType, else the key
+	Delay time.Duration // the keystroke delay in effect for EventType
+}
+
+// String gives a compact form for test failures, e.g. `type "abc"` or
+// `press "ctrl+c"`
+func (e Event) String() string {
+	if e.Delay > 0 {
+		return fmt.Sprintf("%s %q delay=%s", e.Kind, e.Value, e.Delay)
+	}
+	return fmt.Sprintf("%s %q", e.Kind, e.Value)
+}
+
+// Recorder is an in-memory Backend that captures every call instead of
+// sending keys, so code built on kyb can be tested without a display.
+// Keys are checked like a real backend would, unknown names fail with
+// ErrUnknownKey and are not recorded. Typed text, secrets included, is
+// kept in memory; use it in tests only.
+//
+//	rec := kyb.NewRecorder()
+//	kyb.Register(rec, 100)
+//	defer kyb.Unregister(rec.Name())
+type Recorder struct {
+	mu          sync.Mutex
+	events      []Event
+	err         error
+	unavailable bool
+}
+
+// NewRecorder returns an empty, available Recorder named "recorder"
+func NewRecorder() *Recorder {
+	return &Recorder{}
+}
+
+// Name implements Backend
+func (r *Recorder) Name() string {
+	return "recorder"
+}
+
+// Available implements Backend; see SetAvailable
+func (r *Recorder) Available() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return !r.unavailable
+}
+
+// SetAvailable changes what Available reports, to test backend selection
+func (r *Recorder) SetAvailable(ok bool) {
+	r.mu.Lock()
+	r.unavailable = !ok
+	r.mu.Unlock()
+}
+
+// SetError makes every following call fail with err without recording
+// it; nil restores normal operation
+func (r *Recorder) SetError(err error) {
+	r.mu.Lock()
+	r.err = err
+	r.mu.Unlock()
+}
+
+// Type implements Backend
+func (r *Recorder) Type(text string, delay time.Duration) error {
+	return r.record(Event{Kind: EventType, Value: text, Delay: delay})
+}
+
+// KeyPress implements Backend
+func (r *Recorder) KeyPress(key string) error {
+	return r.recordKey(EventKeyPress, key)
+}
+
+// KeyDown implements Backend
+func (r *Recorder) KeyDown(key string) error {
+	return r.recordKey(EventKeyDown, key)
+}
+
+// KeyUp implements Backend
+func (r *Recorder) KeyUp(key string) error {
+	return r.recordKey(EventKeyUp, key)
+}
+
+// Events returns a copy of the events recorded so far
+func (r *Recorder) Events() []Event {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return slices.Clone(r.events)
+}
+
+// Reset forgets the recorded events
+func (r *Recorder) Reset() {
+	r.mu.Lock()
+	clear(r.events)
+	r.events = nil
+	r.mu.Unlock()
+}
+
+func (r *Recorder) recordKey(kind EventKind, key string) error {
+	if _, _, err := parseChord(key); err != nil {
+		return err
+	}
+	return r.record(Event{Kind: kind, Value: key})
+}
+
+func (r *Recorder) record(e Event) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.err != nil {
+		return r.err
+	}
+	r.events = append(r.events, e)
+	return nil
+}
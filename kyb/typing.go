@@ -0,0 +1,199 @@
+// typing.go - Part of the `kyb` Package
+//
+//     ॐ भूर्भुवः स्वः
+//     तत्स॑वि॒तुर्वरे॑ण्यं॒
+//    भर्गो॑ दे॒वस्य॑ धीमहि।
+//   धियो॒ यो नः॑ प्रचो॒दया॑त्॥
+//
+//
+//  बी.स.जी - बोसजी के द्वारा रचित सुरक्षा एवं गोपनीयता हेतु तन्त्राक्ष्।
+// ================================================
+//
+// एक सुरक्षा एवं गोपनीयता केंद्रित तंत्राक्षों का संकलन।
+//
+// एक रचनात्मक भारतीय उत्पाद ।
+//
+// bsg - Boseji's Security and Privacy Utilities
+//
+// A collection of Security and Privacy utilities and some notes for help.
+//
+// This is **Golang** package collection as well as few utility
+// command line programs.
+//
+// Sources
+// -------
+// https://github.com/boseji/bsg
+//
+// License
+// -------
+//
+//   bsg - Boseji's Security and Privacy Utilities.
+//   Copyright (C) 2025-2026 by Abhijit Bose (aka. Boseji)
+//
+//   This program is free software: you can redistribute it and/or modify
+//   it under the terms of the GNU General Public License version 2 only
+//   as published by the Free Software Foundation.
+//
+//   This program is distributed in the hope that it will be useful,
+//   but WITHOUT ANY WARRANTY; without even the implied warranty of
+//   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+//
+//   You should have received a copy of the GNU General Public License
+//   along with this program. If not, see <https://www.gnu.org/licenses/>.
+//
+//  SPDX-License-Identifier: GPL-2.0-only
+//  Full Name: GNU General Public License v2.0 only
+//  Please visit <https://spdx.org/licenses/GPL-2.0-only.html> for details.
+//
+
+package kyb
+
+import (
+	"context"
+	"time"
+
+	"github.com/boseji/bsg/gen"
+)
+
+// TypeOptions holds the timing of a single TypeWithOptions call.
+type TypeOptions struct {
+	Delay        time.Duration // Pause between keystrokes (default is SetDelay).
+	Jitter       time.Duration // Random extra pause of up to Jitter per keystroke.
+	InitialDelay time.Duration // Pause before the first keystroke.
+	ChunkSize    int           // Characters per backend call, 0 for all at once.
+
+	verify func() error // called before every chunk, set by Target
+}
+
+// TypeOption is a function that modifies TypeOptions.
+type TypeOption func(*TypeOptions)
+
+// WithDelay sets the pause between keystrokes for this call only.
+func WithDelay(d time.Duration) TypeOption {
+	return func(opts *TypeOptions) {
+		opts.Delay = d
+	}
+}
+
+// WithJitter adds a random pause between zero and d to every keystroke,
+// drawn from the `gen` package, for human-like timing.
+func WithJitter(d time.Duration) TypeOption {
+	return func(opts *TypeOptions) {
+		opts.Jitter = d
+	}
+}
+
+// WithInitialDelay waits before the first keystroke, e.g. to give the
+// user time to focus the target window.
+func WithInitialDelay(d time.Duration) TypeOption {
+	return func(opts *TypeOptions) {
+		opts.InitialDelay = d
+	}
+}
+
+// WithChunkSize hands the text to the backend n characters at a time.
+// Cancellation is checked between chunks, and the jitter applies to the
+// pauses between them.
+func WithChunkSize(n int) TypeOption {
+	return func(opts *TypeOptions) {
+		opts.ChunkSize = n
+	}
+}
+
+// withVerify calls verify before every chunk, as Target does to check
+// the focus
+func withVerify(verify func() error) TypeOption {
+	return func(opts *TypeOptions) {
+		opts.verify = verify
+	}
+}
+
+// jitterN draws the random part of a pause; tests replace it
+var jitterN = gen.Int64N
+
+// TypeWithOptions types text with the timing of opts instead of the
+// global delay, which it leaves untouched, so concurrent callers do not
+// affect each other. With a jitter and no chunk size every character is
+// its own chunk. Typing stops with the context error once ctx is done;
+// characters already sent stay typed.
+func TypeWithOptions(ctx context.Context, text string, opts ...TypeOption) error {
+	options := &TypeOptions{Delay: getDelay()}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	b, err := Active()
+	if err != nil {
+		return err
+	}
+
+	size := options.ChunkSize
+	if size <= 0 && options.Jitter > 0 {
+		size = 1
+	}
+
+	if err := sleepContext(ctx, options.InitialDelay); err != nil {
+		return err
+	}
+
+	chunks := splitRunes(text, size)
+	for i, chunk := range chunks {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if options.verify != nil {
+			if err := options.verify(); err != nil {
+				return err
+			}
+		}
+
+		if err := b.Type(chunk, options.Delay); err != nil {
+			return err
+		}
+
+		if i < len(chunks)-1 {
+			pause := options.Delay
+			if options.Jitter > 0 {
+				pause += time.Duration(jitterN(int64(options.Jitter) + 1))
+			}
+			if err := sleepContext(ctx, pause); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// sleepContext pauses for d, or until ctx is done
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// splitRunes cuts text into pieces of size characters, or returns it
+// whole for a size of 0
+func splitRunes(text string, size int) []string {
+	if size <= 0 || text == "" {
+		return []string{text}
+	}
+
+	var chunks []string
+	count, start := 0, 0
+	for i := range text {
+		if count == size {
+			chunks = append(chunks, text[start:i])
+			count, start = 0, i
+		}
+		count++
+	}
+	return append(chunks, text[start:])
+}
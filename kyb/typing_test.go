@@ -0,0 +1,240 @@
+// typing_test.go - Test Program `kyb` Package
+//
+//     ॐ भूर्भुवः स्वः
+//     तत्स॑वि॒तुर्वरे॑ण्यं॒
+//    भर्गो॑ दे॒वस्य॑ धीमहि।
+//   धियो॒ यो नः॑ प्रचो॒दया॑त्॥
+//
+//
+//  बी.स.जी - बोसजी के द्वारा रचित सुरक्षा एवं गोपनीयता हेतु तन्त्राक्ष्।
+// ================================================
+//
+// एक सुरक्षा एवं गोपनीयता केंद्रित तंत्राक्षों का संकलन।
+//
+// एक रचनात्मक भारतीय उत्पाद ।
+//
+// bsg - Boseji's Security and Privacy Utilities
+//
+// A collection of Security and Privacy utilities and some notes for help.
+//
+// This is **Golang** package collection as well as few utility
+// command line programs.
+//
+// Sources
+// -------
+// https://github.com/boseji/bsg
+//
+// License
+// -------
+//
+//   bsg - Boseji's Security and Privacy Utilities.
+//   Copyright (C) 2025-2026 by Abhijit Bose (aka. Boseji)
+//
+//   This program is free software: you can redistribute it and/or modify
+//   it under the terms of the GNU General Public License version 2 only
+//   as published by the Free Software Foundation.
+//
+//   This program is distributed in the hope that it will be useful,
+//   but WITHOUT ANY WARRANTY; without even the implied warranty of
+//   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+//
+//   You should have received a copy of the GNU General Public License
+//   along with this program. If not, see <https://www.gnu.org/licenses/>.
+//
+//  SPDX-License-Identifier: GPL-2.0-only
+//  Full Name: GNU General Public License v2.0 only
+//  Please visit <https://spdx.org/licenses/GPL-2.0-only.html> for details.
+//
+
+package kyb
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+)
+
+// plainBackend forwards to a Recorder through the exported methods
+// only, like a backend from outside the package
+type plainBackend struct{ rec *Recorder }
+
+func (p plainBackend) Name() string              { return "plain" }
+func (p plainBackend) Available() bool           { return true }
+func (p plainBackend) KeyPress(key string) error { return p.rec.KeyPress(key) }
+func (p plainBackend) KeyDown(key string) error  { return p.rec.KeyDown(key) }
+func (p plainBackend) KeyUp(key string) error    { return p.rec.KeyUp(key) }
+
+func (p plainBackend) Type(text string, delay time.Duration) error {
+	return p.rec.Type(text, delay)
+}
+
+// cancelAfter cancels a context after the given number of Type calls
+type cancelAfter struct {
+	*Recorder
+	n      int
+	cancel context.CancelFunc
+}
+
+func (c *cancelAfter) Name() string { return "stopper" }
+
+func (c *cancelAfter) Type(text string, delay time.Duration) error {
+	if c.n--; c.n == 0 {
+		c.cancel()
+	}
+	return c.Recorder.Type(text, delay)
+}
+
+func typed(events []Event) []string {
+	var list []string
+	for _, e := range events {
+		list = append(list, e.Value)
+	}
+	return list
+}
+
+// TestTypeWithOptions checks chunking, the per call delay and that the
+// global delay is left alone.
+func TestTypeWithOptions(t *testing.T) {
+	rec := useRecorder(t)
+	SetDelay(30 * time.Millisecond)
+	defer SetDelay(0)
+	ctx := context.Background()
+
+	if err := TypeWithOptions(ctx, "abcdéfg", WithDelay(time.Millisecond), WithChunkSize(3)); err != nil {
+		t.Fatal(err)
+	}
+	if err := TypeWithOptions(ctx, "whole"); err != nil {
+		t.Fatal(err)
+	}
+	if err := TypeFast("fast"); err != nil {
+		t.Fatal(err)
+	}
+	if getDelay() != 30*time.Millisecond {
+		t.Fatalf("global delay changed to %s", getDelay())
+	}
+
+	want := []Event{
+		{Kind: EventType, Value: "abc", Delay: time.Millisecond},
+		{Kind: EventType, Value: "déf", Delay: time.Millisecond},
+		{Kind: EventType, Value: "g", Delay: time.Millisecond},
+		{Kind: EventType, Value: "whole", Delay: 30 * time.Millisecond},
+		{Kind: EventType, Value: "fast"},
+	}
+	if got := rec.Events(); !slices.Equal(got, want) {
+		t.Fatalf("events\n got %v\nwant %v", got, want)
+	}
+}
+
+// TestTypeJitter checks that every keystroke gets its own random pause
+// drawn within the jitter bound.
+func TestTypeJitter(t *testing.T) {
+	rec := useRecorder(t)
+	var draws []int64
+	old := jitterN
+	jitterN = func(n int64) int64 {
+		draws = append(draws, n)
+		return n - 1
+	}
+	defer func() { jitterN = old }()
+
+	start := time.Now()
+	err := TypeWithOptions(context.Background(), "abcd", WithDelay(0), WithJitter(2*time.Millisecond))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := typed(rec.Events()); !slices.Equal(got, []string{"a", "b", "c", "d"}) {
+		t.Fatalf("typed %q", got)
+	}
+	bound := int64(2*time.Millisecond) + 1
+	if !slices.Equal(draws, []int64{bound, bound, bound}) {
+		t.Fatalf("jitter draws = %v", draws)
+	}
+	if elapsed := time.Since(start); elapsed < 6*time.Millisecond {
+		t.Fatalf("typing took %s, want at least 6ms of jitter", elapsed)
+	}
+}
+
+// TestTypeCancel checks cancellation during the initial delay and
+// between chunks.
+func TestTypeCancel(t *testing.T) {
+	rec := useRecorder(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	err := TypeWithOptions(ctx, "never", WithInitialDelay(time.Hour))
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("initial delay = %v", err)
+	}
+	if len(rec.Events()) != 0 {
+		t.Fatalf("typed during the initial delay: %v", rec.Events())
+	}
+
+	ctx, cancel = context.WithCancel(context.Background())
+	defer cancel()
+	stopper := &cancelAfter{Recorder: NewRecorder(), n: 2, cancel: cancel}
+	Register(stopper, 300)
+	defer Unregister(stopper.Name())
+	err = TypeWithOptions(ctx, "abcdef", WithChunkSize(2))
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("Type after cancel = %v", err)
+	}
+	if got := typed(stopper.Events()); !slices.Equal(got, []string{"ab", "cd"}) {
+		t.Fatalf("typed %q before the cancellation", got)
+	}
+}
+
+// TestTypePacedBackend checks that a backend from outside the package
+// gets the per call delay instead of the global one, and only once.
+func TestTypePacedBackend(t *testing.T) {
+	t.Setenv(BackendEnv, "")
+	plain := plainBackend{NewRecorder()}
+	Register(plain, 300)
+	defer Unregister(plain.Name())
+	SetDelay(5 * time.Millisecond)
+	defer SetDelay(0)
+
+	tests := []struct {
+		name string
+		typ  func() error
+		want Event
+	}{
+		{"Type", func() error { return Type("xyz") },
+			Event{Kind: EventType, Value: "xyz", Delay: 5 * time.Millisecond}},
+		{"WithDelay", func() error {
+			return TypeWithOptions(context.Background(), "xyz", WithDelay(time.Millisecond))
+		}, Event{Kind: EventType, Value: "xyz", Delay: time.Millisecond}},
+		{"TypeFast", func() error { return TypeFast("xyz") },
+			Event{Kind: EventType, Value: "xyz"}},
+	}
+	for _, tc := range tests {
+		plain.rec.Reset()
+		if err := tc.typ(); err != nil {
+			t.Fatalf("%s: %v", tc.name, err)
+		}
+		if got := plain.rec.Events(); !slices.Equal(got, []Event{tc.want}) {
+			t.Errorf("%s: events %v, want %v", tc.name, got, tc.want)
+		}
+	}
+}
+
+// TestSplitRunes checks chunking on character boundaries.
+func TestSplitRunes(t *testing.T) {
+	tests := []struct {
+		text string
+		size int
+		want []string
+	}{
+		{"", 2, []string{""}},
+		{"abc", 0, []string{"abc"}},
+		{"abc", 5, []string{"abc"}},
+		{"abcd", 2, []string{"ab", "cd"}},
+		{"ñandú", 2, []string{"ña", "nd", "ú"}},
+	}
+	for _, tc := range tests {
+		if got := splitRunes(tc.text, tc.size); !slices.Equal(got, tc.want) {
+			t.Errorf("splitRunes(%q, %d) = %q, want %q", tc.text, tc.size, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,224 @@
+// window.go - Part of the `kyb` Package
+//
+//     ॐ भूर्भुवः स्वः
+//     तत्स॑वि॒तुर्वरे॑ण्यं॒
+//    भर्गो॑ दे॒वस्य॑ धीमहि।
+//   धियो॒ यो नः॑ प्रचो॒दया॑त्॥
+//
+//
+//  बी.स.जी - बोसजी के द्वारा रचित सुरक्षा एवं गोपनीयता हेतु तन्त्राक्ष्।
+// ================================================
+//
+// एक सुरक्षा एवं गोपनीयता केंद्रित तंत्राक्षों का संकलन।
+//
+// एक रचनात्मक भारतीय उत्पाद ।
+//
+// bsg - Boseji's Security and Privacy Utilities
+//
+// A collection of Security and Privacy utilities and some notes for help.
+//
+// This is **Golang** package collection as well as few utility
+// command line programs.
+//
+// Sources
+// -------
+// https://github.com/boseji/bsg
+//
+// License
+// -------
+//
+//   bsg - Boseji's Security and Privacy Utilities.
+//   Copyright (C) 2025-2026 by Abhijit Bose (aka. Boseji)
+//
+//   This program is free software: you can redistribute it and/or modify
+//   it under the terms of the GNU General Public License version 2 only
+//   as published by the Free Software Foundation.
+//
+//   This program is distributed in the hope that it will be useful,
+//   but WITHOUT ANY WARRANTY; without even the implied warranty of
+//   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+//
+//   You should have received a copy of the GNU General Public License
+//   along with this program. If not, see <https://www.gnu.org/licenses/>.
+//
+//  SPDX-License-Identifier: GPL-2.0-only
+//  Full Name: GNU General Public License v2.0 only
+//  Please visit <https://spdx.org/licenses/GPL-2.0-only.html> for details.
+//
+
+package kyb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"time"
+)
+
+var (
+	// ErrWindowNotFound is returned when no window matches
+	ErrWindowNotFound = errors.New("no matching window")
+	// ErrAmbiguousWindow is returned when several windows match and none
+	// of them has the focus
+	ErrAmbiguousWindow = errors.New("several windows match")
+	// ErrFocusChanged is returned when the target window does not have,
+	// or has lost, the keyboard focus
+	ErrFocusChanged = errors.New("target window does not have the focus")
+)
+
+// FocusTimeout is how long Focus waits for the window manager to hand the
+// focus to the target window
+var FocusTimeout = 2 * time.Second
+
+// TargetChunkSize is the default chunk size of Target.TypeWithOptions, so
+// the focus is checked every few characters
+const TargetChunkSize = 8
+
+// Window is a top level window as seen by the window system
+type Window struct {
+	ID    string // X11 window id, Windows HWND or macOS process id
+	Title string
+	Class string // X11 WM_CLASS, Windows class name or macOS application
+	PID   int
+}
+
+// WindowMatch selects windows by regular expressions on their title and
+// class; nil matches anything, but at least one must be set
+type WindowMatch struct {
+	Title *regexp.Regexp
+	Class *regexp.Regexp
+}
+
+func (m WindowMatch) matches(w Window) bool {
+	return (m.Title == nil || m.Title.MatchString(w.Title)) &&
+		(m.Class == nil || m.Class.MatchString(w.Class))
+}
+
+// windowSystem lists, inspects and activates windows on one OS
+type windowSystem interface {
+	list(m WindowMatch) ([]Window, error)
+	active() (Window, error)
+	activate(w Window) error
+}
+
+// windowSys is the window system of this OS; tests replace it
+var windowSys = platformWindows()
+
+// FindWindows lists the visible windows matching m
+func FindWindows(m WindowMatch) ([]Window, error) {
+	if m.Title == nil && m.Class == nil {
+		return nil, fmt.Errorf("%w: empty window match", ErrWindowNotFound)
+	}
+
+	all, err := windowSys.list(m)
+	if err != nil {
+		return nil, err
+	}
+	var found []Window
+	for _, w := range all {
+		if m.matches(w) {
+			found = append(found, w)
+		}
+	}
+	return found, nil
+}
+
+// ActiveWindow returns the window that has the keyboard focus
+func ActiveWindow() (Window, error) {
+	return windowSys.active()
+}
+
+// Target is a window that typing is bound to. Every Type, KeyPress or
+// auto-type step through it first checks that the window still has the
+// focus, and fails with ErrFocusChanged otherwise, so text never ends up
+// in whatever window took over.
+type Target struct {
+	Window Window
+}
+
+// Focus finds the window matching m, activates it and waits until it has
+// the focus. If several windows match, the one that already has the
+// focus is used, otherwise it fails with ErrAmbiguousWindow.
+func Focus(m WindowMatch) (*Target, error) {
+	found, err := FindWindows(m)
+	if err != nil {
+		return nil, err
+	}
+
+	var w Window
+	switch len(found) {
+	case 0:
+		return nil, ErrWindowNotFound
+	case 1:
+		w = found[0]
+	default:
+		active, err := windowSys.active()
+		if err != nil || !m.matches(active) {
+			return nil, fmt.Errorf("%w: %d windows", ErrAmbiguousWindow, len(found))
+		}
+		w = active
+	}
+
+	t := &Target{Window: w}
+	if err := t.Activate(); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// Activate brings the target window to the front and waits up to
+// FocusTimeout for it to get the focus
+func (t *Target) Activate() error {
+	if err := windowSys.activate(t.Window); err != nil {
+		return err
+	}
+
+	deadline := time.Now().Add(FocusTimeout)
+	for {
+		err := t.Verify()
+		if err == nil || time.Now().After(deadline) {
+			return err
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+}
+
+// Verify checks that the target window has the focus
+func (t *Target) Verify() error {
+	active, err := windowSys.active()
+	if err != nil {
+		return err
+	}
+	if active.ID != t.Window.ID {
+		return fmt.Errorf("%w: %q has it instead of %q", ErrFocusChanged, active.Title, t.Window.Title)
+	}
+	return nil
+}
+
+// Type types text into the target window
+func (t *Target) Type(text string) error {
+	return t.TypeWithOptions(context.Background(), text)
+}
+
+// TypeWithOptions is TypeWithOptions with the focus checked before every
+// chunk. The chunk size defaults to TargetChunkSize.
+func (t *Target) TypeWithOptions(ctx context.Context, text string, opts ...TypeOption) error {
+	opts = append([]TypeOption{WithChunkSize(TargetChunkSize)}, opts...)
+	opts = append(opts, withVerify(t.Verify))
+	return TypeWithOptions(ctx, text, opts...)
+}
+
+// KeyPress presses a key or chord in the target window
+func (t *Target) KeyPress(key string) error {
+	if err := t.Verify(); err != nil {
+		return err
+	}
+	return KeyPress(key)
+}
+
+// RunAutoType runs a sequence in the target window, checking the focus
+// before every step
+func (t *Target) RunAutoType(a *AutoType, fields map[string]string) error {
+	return a.run(fields, t.Verify)
+}
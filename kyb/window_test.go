@@ -0,0 +1,278 @@
+// window_test.go - Test Program `kyb` Package
+//
+//     ॐ भूर्भुवः स्वः
+//     तत्स॑वि॒तुर्वरे॑ण्यं॒
+//    भर्गो॑ दे॒वस्य॑ धीमहि।
+//   धियो॒ यो नः॑ प्रचो॒दया॑त्॥
+//
+//
+//  बी.स.जी - बोसजी के द्वारा रचित सुरक्षा एवं गोपनीयता हेतु तन्त्राक्ष्।
+// ================================================
+//
+// एक सुरक्षा एवं गोपनीयता केंद्रित तंत्राक्षों का संकलन।
+//
+// एक रचनात्मक भारतीय उत्पाद ।
+//
+// bsg - Boseji's Security and Privacy Utilities
+//
+// A collection of Security and Privacy utilities and some notes for help.
+//
+// This is **Golang** package collection as well as few utility
+// command line programs.
+//
+// Sources
+// -------
+// https://github.com/boseji/bsg
+//
+// License
+// -------
+//
+//   bsg - Boseji's Security and Privacy Utilities.
+//   Copyright (C) 2025-2026 by Abhijit Bose (aka. Boseji)
+//
+//   This program is free software: you can redistribute it and/or modify
+//   it under the terms of the GNU General Public License version 2 only
+//   as published by the Free Software Foundation.
+//
+//   This program is distributed in the hope that it will be useful,
+//   but WITHOUT ANY WARRANTY; without even the implied warranty of
+//   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+//
+//   You should have received a copy of the GNU General Public License
+//   along with this program. If not, see <https://www.gnu.org/licenses/>.
+//
+//  SPDX-License-Identifier: GPL-2.0-only
+//  Full Name: GNU General Public License v2.0 only
+//  Please visit <https://spdx.org/licenses/GPL-2.0-only.html> for details.
+//
+
+package kyb
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeWindows is a window system in memory. focusAfter moves the focus
+// to the given window once that many focus checks have been made.
+type fakeWindows struct {
+	mu         sync.Mutex
+	all        []Window
+	focus      string
+	checks     int
+	focusAfter int
+	thief      string
+	refuse     bool // activate does nothing
+}
+
+func (f *fakeWindows) list(WindowMatch) ([]Window, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return slices.Clone(f.all), nil
+}
+
+func (f *fakeWindows) active() (Window, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.checks++
+	if f.thief != "" && f.checks > f.focusAfter {
+		f.focus = f.thief
+	}
+	for _, w := range f.all {
+		if w.ID == f.focus {
+			return w, nil
+		}
+	}
+	return Window{}, errors.New("no focus")
+}
+
+func (f *fakeWindows) activate(w Window) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if !f.refuse {
+		f.focus = w.ID
+	}
+	return nil
+}
+
+// useWindows installs a fake window system for one test
+func useWindows(t *testing.T, f *fakeWindows) {
+	t.Helper()
+	old := windowSys
+	windowSys = f
+	t.Cleanup(func() { windowSys = old })
+}
+
+func testWindows() *fakeWindows {
+	return &fakeWindows{
+		all: []Window{
+			{ID: "1", Title: "Team Chat", Class: "chat"},
+			{ID: "2", Title: "Login - Browser", Class: "browser"},
+			{ID: "3", Title: "Docs - Browser", Class: "browser"},
+		},
+		focus: "1",
+	}
+}
+
+// TestFindWindows checks matching by title and class.
+func TestFindWindows(t *testing.T) {
+	useWindows(t, testWindows())
+
+	tests := []struct {
+		m    WindowMatch
+		want []string
+	}{
+		{WindowMatch{Title: regexp.MustCompile(`(?i)^login`)}, []string{"2"}},
+		{WindowMatch{Class: regexp.MustCompile(`^browser$`)}, []string{"2", "3"}},
+		{WindowMatch{Title: regexp.MustCompile(`Docs`), Class: regexp.MustCompile(`browser`)}, []string{"3"}},
+		{WindowMatch{Title: regexp.MustCompile(`nothing`)}, nil},
+	}
+	for _, tc := range tests {
+		found, err := FindWindows(tc.m)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var ids []string
+		for _, w := range found {
+			ids = append(ids, w.ID)
+		}
+		if !slices.Equal(ids, tc.want) {
+			t.Errorf("FindWindows(%+v) = %v, want %v", tc.m, ids, tc.want)
+		}
+	}
+	if _, err := FindWindows(WindowMatch{}); !errors.Is(err, ErrWindowNotFound) {
+		t.Errorf("empty match = %v", err)
+	}
+}
+
+// TestFocus checks activation, ambiguity and a window manager that does
+// not hand over the focus.
+func TestFocus(t *testing.T) {
+	f := testWindows()
+	useWindows(t, f)
+
+	target, err := Focus(WindowMatch{Title: regexp.MustCompile(`Login`)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if target.Window.ID != "2" || f.focus != "2" {
+		t.Fatalf("focused %+v, focus now on %s", target.Window, f.focus)
+	}
+
+	browser := WindowMatch{Class: regexp.MustCompile(`browser`)}
+	if target, err = Focus(browser); err != nil || target.Window.ID != "2" {
+		t.Fatalf("ambiguous match with a focused candidate = %v, %v", target, err)
+	}
+	f.focus = "1"
+	if _, err := Focus(browser); !errors.Is(err, ErrAmbiguousWindow) {
+		t.Fatalf("ambiguous match = %v", err)
+	}
+	if _, err := Focus(WindowMatch{Title: regexp.MustCompile(`nope`)}); !errors.Is(err, ErrWindowNotFound) {
+		t.Fatalf("missing window = %v", err)
+	}
+
+	f.refuse = true
+	old := FocusTimeout
+	FocusTimeout = 50 * time.Millisecond
+	defer func() { FocusTimeout = old }()
+	if _, err := Focus(WindowMatch{Title: regexp.MustCompile(`Docs`)}); !errors.Is(err, ErrFocusChanged) {
+		t.Fatalf("refused activation = %v", err)
+	}
+}
+
+// TestTargetFocusChange checks that typing stops as soon as another
+// window takes the focus.
+func TestTargetFocusChange(t *testing.T) {
+	rec := useRecorder(t)
+	f := testWindows()
+	useWindows(t, f)
+
+	target, err := Focus(WindowMatch{Title: regexp.MustCompile(`Login`)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := target.Type("0123456789abcdefXYZ"); err != nil {
+		t.Fatal(err)
+	}
+	if got := typed(rec.Events()); !slices.Equal(got, []string{"01234567", "89abcdef", "XYZ"}) {
+		t.Fatalf("typed %q", got)
+	}
+
+	// The chat window grabs the focus after two more checks
+	rec.Reset()
+	f.thief, f.focusAfter = "1", f.checks+2
+	err = target.TypeWithOptions(context.Background(), "123456", WithChunkSize(2))
+	if !errors.Is(err, ErrFocusChanged) {
+		t.Fatalf("Type after focus change = %v", err)
+	}
+	if got := typed(rec.Events()); !slices.Equal(got, []string{"12", "34"}) {
+		t.Fatalf("typed %q before the focus change", got)
+	}
+	if err := target.KeyPress("enter"); !errors.Is(err, ErrFocusChanged) {
+		t.Fatalf("KeyPress after focus change = %v", err)
+	}
+}
+
+// TestTargetAutoType checks the focus before each auto-type step.
+func TestTargetAutoType(t *testing.T) {
+	rec := useRecorder(t)
+	f := testWindows()
+	useWindows(t, f)
+
+	target, err := Focus(WindowMatch{Title: regexp.MustCompile(`Login`)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	a, err := ParseAutoType("{USERNAME}{TAB}{PASSWORD}{ENTER}")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	f.thief, f.focusAfter = "1", f.checks+2
+	err = target.RunAutoType(a, map[string]string{"username": "alice", "password": "secret"})
+	if !errors.Is(err, ErrFocusChanged) {
+		t.Fatalf("RunAutoType = %v", err)
+	}
+	want := []Event{{Kind: EventType, Value: "alice"}, {Kind: EventKeyPress, Value: "tab"}}
+	if got := rec.Events(); !slices.Equal(got, want) {
+		t.Fatalf("events %v, the password must not be typed", got)
+	}
+}
+
+// TestTargetAutoTypeField checks the focus within a long field, not just
+// before it.
+func TestTargetAutoTypeField(t *testing.T) {
+	rec := useRecorder(t)
+	f := testWindows()
+	useWindows(t, f)
+
+	target, err := Focus(WindowMatch{Title: regexp.MustCompile(`Login`)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	a, err := ParseAutoType("{USERNAME}{TAB}{PASSWORD}{ENTER}")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// alice, tab and the first chunk of the password pass, then focus moves
+	f.thief, f.focusAfter = "1", f.checks+3
+	err = target.RunAutoType(a, map[string]string{
+		"username": "alice", "password": "correct horse battery staple"})
+	if !errors.Is(err, ErrFocusChanged) {
+		t.Fatalf("RunAutoType = %v", err)
+	}
+	want := []Event{
+		{Kind: EventType, Value: "alice"},
+		{Kind: EventKeyPress, Value: "tab"},
+		{Kind: EventType, Value: "correct "},
+	}
+	if got := rec.Events(); !slices.Equal(got, want) {
+		t.Fatalf("events %v, want %v", got, want)
+	}
+}
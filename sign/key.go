@@ -0,0 +1,234 @@
+// key.go - Part of the `sign` Package
+//
+//     ॐ भूर्भुवः स्वः
+//     तत्स॑वि॒तुर्वरे॑ण्यं॒
+//    भर्गो॑ दे॒वस्य॑ धीमहि।
+//   धियो॒ यो नः॑ प्रचो॒दया॑त्॥
+//
+//
+//  बी.स.जी - बोसजी के द्वारा रचित सुरक्षा एवं गोपनीयता हेतु तन्त्राक्ष्।
+// ================================================
+//
+// एक सुरक्षा एवं गोपनीयता केंद्रित तंत्राक्षों का संकलन।
+//
+// एक रचनात्मक भारतीय उत्पाद ।
+//
+// bsg - Boseji's Security and Privacy Utilities
+//
+// A collection of Security and Privacy utilities and some notes for help.
+//
+// This is **Golang** package collection as well as few utility
+// command line programs.
+//
+// Sources
+// -------
+// https://github.com/boseji/bsg
+//
+// License
+// -------
+//
+//   bsg - Boseji's Security and Privacy Utilities.
+//   Copyright (C) 2025-2026 by Abhijit Bose (aka. Boseji)
+//
+//   This program is free software: you can redistribute it and/or modify
+//   it under the terms of the GNU General Public License version 2 only
+//   as published by the Free Software Foundation.
+//
+//   This program is distributed in the hope that it will be useful,
+//   but WITHOUT ANY WARRANTY; without even the implied warranty of
+//   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+//
+//   You should have received a copy of the GNU General Public License
+//   along with this program. If not, see <https://www.gnu.org/licenses/>.
+//
+//  SPDX-License-Identifier: GPL-2.0-only
+//  Full Name: GNU General Public License v2.0 only
+//  Please visit <https://spdx.org/licenses/GPL-2.0-only.html> for details.
+//
+
+package sign
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/pem"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/blake2b"
+)
+
+// KeyIDSize is the length of a key identifier.
+const KeyIDSize = 8
+
+// KeyID identifies the key that made a signature.
+type KeyID [KeyIDSize]byte
+
+// String returns the identifier as minisign prints it: the 8 bytes read
+// as a little-endian number in upper case hex.
+func (id KeyID) String() string {
+	return fmt.Sprintf("%016X", binary.LittleEndian.Uint64(id[:]))
+}
+
+// keyIDFor derives the identifier of a public key, so that every
+// encoding of the key gives the same identifier.
+func keyIDFor(pub ed25519.PublicKey) KeyID {
+	sum := blake2b.Sum256(pub)
+	var id KeyID
+	copy(id[:], sum[:KeyIDSize])
+	return id
+}
+
+// PublicKey is an Ed25519 public key with its identifier.
+type PublicKey struct {
+	ID  KeyID
+	Key ed25519.PublicKey
+}
+
+// PrivateKey is an Ed25519 private key with its identifier.
+type PrivateKey struct {
+	ID  KeyID
+	Key ed25519.PrivateKey
+}
+
+// GenerateKey creates a new random Ed25519 key pair.
+func GenerateKey() (*PrivateKey, error) {
+	_, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	return newPrivateKey(priv), nil
+}
+
+// newPrivateKey wraps priv with its derived identifier.
+func newPrivateKey(priv ed25519.PrivateKey) *PrivateKey {
+	return &PrivateKey{ID: keyIDFor(priv.Public().(ed25519.PublicKey)), Key: priv}
+}
+
+// newPublicKey wraps pub with its derived identifier.
+func newPublicKey(pub ed25519.PublicKey) *PublicKey {
+	return &PublicKey{ID: keyIDFor(pub), Key: pub}
+}
+
+// Public returns the public half of the key pair.
+func (k *PrivateKey) Public() *PublicKey {
+	return &PublicKey{ID: k.ID, Key: k.Key.Public().(ed25519.PublicKey)}
+}
+
+// Seed returns the raw 32 byte private key seed.
+func (k *PrivateKey) Seed() []byte {
+	return k.Key.Seed()
+}
+
+// Bytes returns the raw 32 byte public key.
+func (p *PublicKey) Bytes() []byte {
+	return append([]byte{}, p.Key...)
+}
+
+// NewPrivateKey creates a key pair from a raw 32 byte seed.
+func NewPrivateKey(seed []byte) (*PrivateKey, error) {
+	if len(seed) != ed25519.SeedSize {
+		return nil, fmt.Errorf("%w: seed must be %d bytes", ErrInvalidKey, ed25519.SeedSize)
+	}
+	return newPrivateKey(ed25519.NewKeyFromSeed(seed)), nil
+}
+
+// NewPublicKey creates a public key from its raw 32 bytes.
+func NewPublicKey(raw []byte) (*PublicKey, error) {
+	if len(raw) != ed25519.PublicKeySize {
+		return nil, fmt.Errorf("%w: public key must be %d bytes",
+			ErrInvalidKey, ed25519.PublicKeySize)
+	}
+	return newPublicKey(append(ed25519.PublicKey{}, raw...)), nil
+}
+
+// MarshalPEM encodes the private key as a PKCS #8 "PRIVATE KEY" block.
+func (k *PrivateKey) MarshalPEM() ([]byte, error) {
+	der, err := x509.MarshalPKCS8PrivateKey(k.Key)
+	if err != nil {
+		return nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), nil
+}
+
+// MarshalPEM encodes the public key as a PKIX "PUBLIC KEY" block.
+func (p *PublicKey) MarshalPEM() ([]byte, error) {
+	der, err := x509.MarshalPKIXPublicKey(p.Key)
+	if err != nil {
+		return nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), nil
+}
+
+// ParsePrivateKeyPEM decodes a PKCS #8 Ed25519 private key.
+func ParsePrivateKeyPEM(data []byte) (*PrivateKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil || block.Type != "PRIVATE KEY" {
+		return nil, fmt.Errorf("%w: no PRIVATE KEY block", ErrInvalidKey)
+	}
+	k, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidKey, err)
+	}
+	priv, ok := k.(ed25519.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("%w: not an Ed25519 key", ErrInvalidKey)
+	}
+	return newPrivateKey(priv), nil
+}
+
+// ParsePublicKeyPEM decodes a PKIX Ed25519 public key.
+func ParsePublicKeyPEM(data []byte) (*PublicKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil || block.Type != "PUBLIC KEY" {
+		return nil, fmt.Errorf("%w: no PUBLIC KEY block", ErrInvalidKey)
+	}
+	k, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidKey, err)
+	}
+	pub, ok := k.(ed25519.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("%w: not an Ed25519 key", ErrInvalidKey)
+	}
+	return newPublicKey(pub), nil
+}
+
+// minisignPublicPrefix is the untrusted comment of a minisign public key.
+const minisignPublicPrefix = "minisign public key "
+
+// MarshalMinisign encodes the public key in the two line minisign
+// format.
+func (p *PublicKey) MarshalMinisign() []byte {
+	b := append([]byte(algLegacy), p.ID[:]...)
+	b = append(b, p.Key...)
+	return []byte(untrustedPrefix + minisignPublicPrefix + p.ID.String() + "\n" +
+		base64.StdEncoding.EncodeToString(b) + "\n")
+}
+
+// ParseMinisignPublicKey decodes a minisign public key, either the full
+// two line file or only its base64 line. The identifier stored in the
+// key is kept.
+func ParseMinisignPublicKey(data []byte) (*PublicKey, error) {
+	var line string
+	for _, l := range strings.Split(string(data), "\n") {
+		l = strings.TrimSpace(l)
+		if l != "" && !strings.HasPrefix(l, untrustedPrefix) {
+			line = l
+			break
+		}
+	}
+	b, err := base64.StdEncoding.DecodeString(line)
+	if err != nil || len(b) != 2+KeyIDSize+ed25519.PublicKeySize {
+		return nil, fmt.Errorf("%w: bad minisign public key", ErrInvalidKey)
+	}
+	if string(b[:2]) != algLegacy {
+		return nil, fmt.Errorf("%w: %q", ErrUnsupportedAlgorithm, b[:2])
+	}
+	p := &PublicKey{Key: append(ed25519.PublicKey{}, b[2+KeyIDSize:]...)}
+	copy(p.ID[:], b[2:2+KeyIDSize])
+	return p, nil
+}
@@ -0,0 +1,179 @@
+// key_test.go - Test Program `sign` Package
+//
+//     ॐ भूर्भुवः स्वः
+//     तत्स॑वि॒तुर्वरे॑ण्यं॒
+//    भर्गो॑ दे॒वस्य॑ धीमहि।
+//   धियो॒ यो नः॑ प्रचो॒दया॑त्॥
+//
+//
+//  बी.स.जी - बोसजी के द्वारा रचित सुरक्षा एवं गोपनीयता हेतु तन्त्राक्ष्।
+// ================================================
+//
+// एक सुरक्षा एवं गोपनीयता केंद्रित तंत्राक्षों का संकलन।
+//
+// एक रचनात्मक भारतीय उत्पाद ।
+//
+// bsg - Boseji's Security and Privacy Utilities
+//
+// A collection of Security and Privacy utilities and some notes for help.
+//
+// This is **Golang** package collection as well as few utility
+// command line programs.
+//
+// Sources
+// -------
+// https://github.com/boseji/bsg
+//
+// License
+// -------
+//
+//   bsg - Boseji's Security and Privacy Utilities.
+//   Copyright (C) 2025-2026 by Abhijit Bose (aka. Boseji)
+//
+//   This program is free software: you can redistribute it and/or modify
+//   it under the terms of the GNU General Public License version 2 only
+//   as published by the Free Software Foundation.
+//
+//   This program is distributed in the hope that it will be useful,
+//   but WITHOUT ANY WARRANTY; without even the implied warranty of
+//   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+//
+//   You should have received a copy of the GNU General Public License
+//   along with this program. If not, see <https://www.gnu.org/licenses/>.
+//
+//  SPDX-License-Identifier: GPL-2.0-only
+//  Full Name: GNU General Public License v2.0 only
+//  Please visit <https://spdx.org/licenses/GPL-2.0-only.html> for details.
+//
+
+package sign_test
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/boseji/bsg/sign"
+)
+
+func TestKeyEncodings(t *testing.T) {
+	k, err := sign.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	pub := k.Public()
+
+	t.Run("raw", func(t *testing.T) {
+		k2, err := sign.NewPrivateKey(k.Seed())
+		if err != nil || !k2.Key.Equal(k.Key) || k2.ID != k.ID {
+			t.Fatalf("NewPrivateKey: %v", err)
+		}
+		p2, err := sign.NewPublicKey(pub.Bytes())
+		if err != nil || !p2.Key.Equal(pub.Key) || p2.ID != pub.ID {
+			t.Fatalf("NewPublicKey: %v", err)
+		}
+		if _, err := sign.NewPublicKey([]byte{1, 2}); !errors.Is(err, sign.ErrInvalidKey) {
+			t.Fatalf("short key: %v", err)
+		}
+	})
+
+	t.Run("PEM", func(t *testing.T) {
+		b, err := k.MarshalPEM()
+		if err != nil {
+			t.Fatal(err)
+		}
+		k2, err := sign.ParsePrivateKeyPEM(b)
+		if err != nil || !k2.Key.Equal(k.Key) || k2.ID != k.ID {
+			t.Fatalf("ParsePrivateKeyPEM: %v", err)
+		}
+		b, err = pub.MarshalPEM()
+		if err != nil {
+			t.Fatal(err)
+		}
+		p2, err := sign.ParsePublicKeyPEM(b)
+		if err != nil || !p2.Key.Equal(pub.Key) {
+			t.Fatalf("ParsePublicKeyPEM: %v", err)
+		}
+		if _, err := sign.ParsePublicKeyPEM([]byte("junk")); !errors.Is(err, sign.ErrInvalidKey) {
+			t.Fatalf("junk: %v", err)
+		}
+	})
+
+	t.Run("OpenSSH", func(t *testing.T) {
+		line := pub.MarshalAuthorizedKey("release@bsg")
+		p2, comment, err := sign.ParseAuthorizedKey(line)
+		if err != nil || !p2.Key.Equal(pub.Key) || comment != "release@bsg" {
+			t.Fatalf("ParseAuthorizedKey = %v, %q", err, comment)
+		}
+		b, err := k.MarshalOpenSSH("release@bsg")
+		if err != nil {
+			t.Fatal(err)
+		}
+		k2, comment, err := sign.ParseOpenSSHPrivateKey(b)
+		if err != nil || !k2.Key.Equal(k.Key) || comment != "release@bsg" {
+			t.Fatalf("ParseOpenSSHPrivateKey = %v, %q", err, comment)
+		}
+		if _, _, err := sign.ParseAuthorizedKey("ssh-rsa AAAA x"); !errors.Is(err, sign.ErrUnsupportedAlgorithm) {
+			t.Fatalf("rsa: %v", err)
+		}
+	})
+
+	t.Run("minisign", func(t *testing.T) {
+		b := pub.MarshalMinisign()
+		if !strings.HasPrefix(string(b), "untrusted comment: minisign public key "+pub.ID.String()) {
+			t.Fatalf("minisign key %q", b)
+		}
+		p2, err := sign.ParseMinisignPublicKey(b)
+		if err != nil || !p2.Key.Equal(pub.Key) || p2.ID != pub.ID {
+			t.Fatalf("ParseMinisignPublicKey: %v", err)
+		}
+		line := strings.Split(string(b), "\n")[1]
+		if _, err := sign.ParseMinisignPublicKey([]byte(line)); err != nil {
+			t.Fatalf("single line: %v", err)
+		}
+	})
+}
+
+// TestOpenSSHInterop checks the OpenSSH encodings against ssh-keygen.
+func TestOpenSSHInterop(t *testing.T) {
+	keygen, err := exec.LookPath("ssh-keygen")
+	if err != nil {
+		t.Skip("ssh-keygen not installed")
+	}
+	dir := t.TempDir()
+	k, _ := sign.GenerateKey()
+	b, _ := k.MarshalOpenSSH("bsg")
+	path := filepath.Join(dir, "id_ed25519")
+	if err := os.WriteFile(path, b, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	out, err := exec.Command(keygen, "-y", "-f", path).Output()
+	if err != nil {
+		t.Fatalf("ssh-keygen -y: %v", err)
+	}
+	want := k.Public().MarshalAuthorizedKey("")
+	if !strings.HasPrefix(strings.TrimSpace(string(out)), want) {
+		t.Fatalf("ssh-keygen derived %q, want %q", out, want)
+	}
+
+	// And a key written by ssh-keygen reads back.
+	path2 := filepath.Join(dir, "gen")
+	if err := exec.Command(keygen, "-q", "-t", "ed25519", "-N", "", "-C", "made",
+		"-f", path2).Run(); err != nil {
+		t.Fatalf("ssh-keygen -t: %v", err)
+	}
+	priv, _ := os.ReadFile(path2)
+	pubLine, _ := os.ReadFile(path2 + ".pub")
+	k2, comment, err := sign.ParseOpenSSHPrivateKey(priv)
+	if err != nil || comment != "made" {
+		t.Fatalf("ParseOpenSSHPrivateKey = %v, %q", err, comment)
+	}
+	p2, _, err := sign.ParseAuthorizedKey(string(pubLine))
+	if err != nil || !bytes.Equal(p2.Bytes(), k2.Public().Bytes()) {
+		t.Fatalf("public keys differ: %v", err)
+	}
+}
@@ -0,0 +1,243 @@
+// openssh.go - Part of the `sign` Package
+//
+//     ॐ भूर्भुवः स्वः
+//     तत्स॑वि॒तुर्वरे॑ण्यं॒
+//    भर्गो॑ दे॒वस्य॑ धीमहि।
+//   धियो॒ यो नः॑ प्रचो॒दया॑त्॥
+//
+//
+//  बी.स.जी - बोसजी के द्वारा रचित सुरक्षा एवं गोपनीयता हेतु तन्त्राक्ष्।
+// ================================================
+//
+// एक सुरक्षा एवं गोपनीयता केंद्रित तंत्राक्षों का संकलन।
+//
+// एक रचनात्मक भारतीय उत्पाद ।
+//
+// bsg - Boseji's Security and Privacy Utilities
+//
+// A collection of Security and Privacy utilities and some notes for help.
+//
+// This is **Golang** package collection as well as few utility
+// command line programs.
+//
+// Sources
+// -------
+// https://github.com/boseji/bsg
+//
+// License
+// -------
+//
+//   bsg - Boseji's Security and Privacy Utilities.
+//   Copyright (C) 2025-2026 by Abhijit Bose (aka. Boseji)
+//
+//   This program is free software: you can redistribute it and/or modify
+//   it under the terms of the GNU General Public License version 2 only
+//   as published by the Free Software Foundation.
+//
+//   This program is distributed in the hope that it will be useful,
+//   but WITHOUT ANY WARRANTY; without even the implied warranty of
+//   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+//
+//   You should have received a copy of the GNU General Public License
+//   along with this program. If not, see <https://www.gnu.org/licenses/>.
+//
+//  SPDX-License-Identifier: GPL-2.0-only
+//  Full Name: GNU General Public License v2.0 only
+//  Please visit <https://spdx.org/licenses/GPL-2.0-only.html> for details.
+//
+
+package sign
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/pem"
+	"fmt"
+	"strings"
+)
+
+const (
+	sshKeyType    = "ssh-ed25519"
+	sshKeyMagic   = "openssh-key-v1\x00"
+	sshPEMType    = "OPENSSH PRIVATE KEY"
+	sshBlockAlign = 8
+)
+
+// MarshalAuthorizedKey encodes the public key as one authorized_keys
+// line, "ssh-ed25519 <base64> <comment>".
+func (p *PublicKey) MarshalAuthorizedKey(comment string) string {
+	s := sshKeyType + " " + base64.StdEncoding.EncodeToString(sshPublicBlob(p.Key))
+	if comment != "" {
+		s += " " + comment
+	}
+	return s
+}
+
+// ParseAuthorizedKey decodes an "ssh-ed25519" authorized_keys line,
+// returning the key and its comment.
+func ParseAuthorizedKey(line string) (*PublicKey, string, error) {
+	fields := strings.Fields(line)
+	if len(fields) < 2 {
+		return nil, "", fmt.Errorf("%w: bad authorized key line", ErrInvalidKey)
+	}
+	if fields[0] != sshKeyType {
+		return nil, "", fmt.Errorf("%w: %q", ErrUnsupportedAlgorithm, fields[0])
+	}
+	blob, err := base64.StdEncoding.DecodeString(fields[1])
+	if err != nil {
+		return nil, "", fmt.Errorf("%w: %v", ErrInvalidKey, err)
+	}
+	pub, err := parseSSHPublicBlob(blob)
+	if err != nil {
+		return nil, "", err
+	}
+	return newPublicKey(pub), strings.Join(fields[2:], " "), nil
+}
+
+// MarshalOpenSSH encodes the private key as an unencrypted
+// "OPENSSH PRIVATE KEY" block, as written by ssh-keygen.
+func (k *PrivateKey) MarshalOpenSSH(comment string) ([]byte, error) {
+	var check [4]byte
+	if _, err := rand.Read(check[:]); err != nil {
+		return nil, err
+	}
+	pub := k.Key.Public().(ed25519.PublicKey)
+
+	var priv bytes.Buffer
+	priv.Write(check[:])
+	priv.Write(check[:])
+	sshString(&priv, []byte(sshKeyType))
+	sshString(&priv, pub)
+	sshString(&priv, k.Key)
+	sshString(&priv, []byte(comment))
+	for i := byte(1); priv.Len()%sshBlockAlign != 0; i++ {
+		priv.WriteByte(i)
+	}
+
+	var b bytes.Buffer
+	b.WriteString(sshKeyMagic)
+	sshString(&b, []byte("none"))
+	sshString(&b, []byte("none"))
+	sshString(&b, nil)
+	binary.Write(&b, binary.BigEndian, uint32(1))
+	sshString(&b, sshPublicBlob(pub))
+	sshString(&b, priv.Bytes())
+	clear(priv.Bytes())
+	return pem.EncodeToMemory(&pem.Block{Type: sshPEMType, Bytes: b.Bytes()}), nil
+}
+
+// ParseOpenSSHPrivateKey decodes an unencrypted Ed25519
+// "OPENSSH PRIVATE KEY" block, returning the key and its comment.
+func ParseOpenSSHPrivateKey(data []byte) (*PrivateKey, string, error) {
+	block, _ := pem.Decode(data)
+	if block == nil || block.Type != sshPEMType {
+		return nil, "", fmt.Errorf("%w: no %s block", ErrInvalidKey, sshPEMType)
+	}
+	r := &sshReader{b: block.Bytes}
+	if !bytes.HasPrefix(r.b, []byte(sshKeyMagic)) {
+		return nil, "", fmt.Errorf("%w: bad OpenSSH magic", ErrInvalidKey)
+	}
+	r.b = r.b[len(sshKeyMagic):]
+	cipher, kdf, _ := r.string(), r.string(), r.string()
+	if r.err == nil && (string(cipher) != "none" || string(kdf) != "none") {
+		return nil, "", ErrEncryptedKey
+	}
+	if n := r.uint32(); r.err == nil && n != 1 {
+		return nil, "", fmt.Errorf("%w: %d keys in file", ErrInvalidKey, n)
+	}
+	r.string() // public key, repeated in the private section
+	priv := &sshReader{b: r.string()}
+	if r.err != nil {
+		return nil, "", r.err
+	}
+
+	c1, c2 := priv.uint32(), priv.uint32()
+	typ, pub, key, comment := priv.string(), priv.string(), priv.string(), priv.string()
+	switch {
+	case priv.err != nil:
+		return nil, "", priv.err
+	case c1 != c2:
+		return nil, "", fmt.Errorf("%w: check values differ", ErrInvalidKey)
+	case string(typ) != sshKeyType:
+		return nil, "", fmt.Errorf("%w: %q", ErrUnsupportedAlgorithm, typ)
+	case len(pub) != ed25519.PublicKeySize || len(key) != ed25519.PrivateKeySize:
+		return nil, "", fmt.Errorf("%w: bad Ed25519 key size", ErrInvalidKey)
+	}
+	k, err := NewPrivateKey(key[:ed25519.SeedSize])
+	if err != nil {
+		return nil, "", err
+	}
+	if !bytes.Equal(k.Key.Public().(ed25519.PublicKey), pub) {
+		return nil, "", fmt.Errorf("%w: public key does not match", ErrInvalidKey)
+	}
+	return k, string(comment), nil
+}
+
+// sshPublicBlob returns the SSH wire encoding of an Ed25519 public key.
+func sshPublicBlob(pub ed25519.PublicKey) []byte {
+	var b bytes.Buffer
+	sshString(&b, []byte(sshKeyType))
+	sshString(&b, pub)
+	return b.Bytes()
+}
+
+// parseSSHPublicBlob decodes the SSH wire encoding of a public key.
+func parseSSHPublicBlob(blob []byte) (ed25519.PublicKey, error) {
+	r := &sshReader{b: blob}
+	typ, pub := r.string(), r.string()
+	if r.err != nil {
+		return nil, r.err
+	}
+	if string(typ) != sshKeyType {
+		return nil, fmt.Errorf("%w: %q", ErrUnsupportedAlgorithm, typ)
+	}
+	if len(pub) != ed25519.PublicKeySize || len(r.b) != 0 {
+		return nil, fmt.Errorf("%w: bad Ed25519 public key", ErrInvalidKey)
+	}
+	return append(ed25519.PublicKey{}, pub...), nil
+}
+
+// sshString writes a length prefixed SSH string.
+func sshString(b *bytes.Buffer, s []byte) {
+	binary.Write(b, binary.BigEndian, uint32(len(s)))
+	b.Write(s)
+}
+
+// sshReader reads SSH wire values, remembering the first error.
+type sshReader struct {
+	b   []byte
+	err error
+}
+
+// uint32 reads a big-endian uint32.
+func (r *sshReader) uint32() uint32 {
+	if r.err != nil || len(r.b) < 4 {
+		r.fail()
+		return 0
+	}
+	v := binary.BigEndian.Uint32(r.b)
+	r.b = r.b[4:]
+	return v
+}
+
+// string reads a length prefixed SSH string.
+func (r *sshReader) string() []byte {
+	n := r.uint32()
+	if r.err != nil || uint64(n) > uint64(len(r.b)) {
+		r.fail()
+		return nil
+	}
+	s := r.b[:n]
+	r.b = r.b[n:]
+	return s
+}
+
+// fail records a short read.
+func (r *sshReader) fail() {
+	if r.err == nil {
+		r.err = fmt.Errorf("%w: truncated OpenSSH data", ErrInvalidKey)
+	}
+}
@@ -0,0 +1,287 @@
+// sign.go - Part of the `sign` Package
+//
+//     ॐ भूर्भुवः स्वः
+//     तत्स॑वि॒तुर्वरे॑ण्यं॒
+//    भर्गो॑ दे॒वस्य॑ धीमहि।
+//   धियो॒ यो नः॑ प्रचो॒दया॑त्॥
+//
+//
+//  बी.स.जी - बोसजी के द्वारा रचित सुरक्षा एवं गोपनीयता हेतु तन्त्राक्ष्।
+// ================================================
+//
+// एक सुरक्षा एवं गोपनीयता केंद्रित तंत्राक्षों का संकलन।
+//
+// एक रचनात्मक भारतीय उत्पाद ।
+//
+// bsg - Boseji's Security and Privacy Utilities
+//
+// A collection of Security and Privacy utilities and some notes for help.
+//
+// This is **Golang** package collection as well as few utility
+// command line programs.
+//
+// Sources
+// -------
+// https://github.com/boseji/bsg
+//
+// License
+// -------
+//
+//   bsg - Boseji's Security and Privacy Utilities.
+//   Copyright (C) 2025-2026 by Abhijit Bose (aka. Boseji)
+//
+//   This program is free software: you can redistribute it and/or modify
+//   it under the terms of the GNU General Public License version 2 only
+//   as published by the Free Software Foundation.
+//
+//   This program is distributed in the hope that it will be useful,
+//   but WITHOUT ANY WARRANTY; without even the implied warranty of
+//   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+//
+//   You should have received a copy of the GNU General Public License
+//   along with this program. If not, see <https://www.gnu.org/licenses/>.
+//
+//  SPDX-License-Identifier: GPL-2.0-only
+//  Full Name: GNU General Public License v2.0 only
+//  Please visit <https://spdx.org/licenses/GPL-2.0-only.html> for details.
+//
+
+// Package sign creates and verifies Ed25519 detached signatures in the
+// minisign format.
+//
+// Files are signed in a single pass: the content is hashed with
+// BLAKE2b-512 and the digest is signed, as minisign does for its "ED"
+// algorithm. A trusted comment, such as a timestamp and file name, is
+// covered by a second global signature so it cannot be changed either.
+// Keys can be stored as PEM, OpenSSH, minisign public keys or raw bytes.
+package sign
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/blake2b"
+)
+
+const (
+	// algPrehashed signs the BLAKE2b-512 digest of the content.
+	algPrehashed = "ED"
+	// algLegacy signs the content itself; it is only verified.
+	algLegacy = "Ed"
+
+	untrustedPrefix = "untrusted comment: "
+	trustedPrefix   = "trusted comment: "
+)
+
+var (
+	ErrInvalidKey           = errors.New("sign: invalid key")
+	ErrEncryptedKey         = errors.New("sign: encrypted keys are not supported")
+	ErrUnsupportedAlgorithm = errors.New("sign: unsupported algorithm")
+	ErrInvalidSignature     = errors.New("sign: malformed signature")
+	ErrKeyIDMismatch        = errors.New("sign: signature was made by a different key")
+	ErrVerification         = errors.New("sign: signature verification failed")
+	ErrTrustedComment       = errors.New("sign: trusted comment signature failed")
+)
+
+// Signature is a detached minisign signature.
+type Signature struct {
+	Algorithm        string                      // "ED" (prehashed) or "Ed".
+	KeyID            KeyID                       // Identifier of the signing key.
+	Signature        [ed25519.SignatureSize]byte // Over the content or its digest.
+	UntrustedComment string                      // Free text, not authenticated.
+	TrustedComment   string                      // Authenticated by GlobalSignature.
+	GlobalSignature  [ed25519.SignatureSize]byte // Over Signature and TrustedComment.
+}
+
+// Options holds the comments written with a signature.
+type Options struct {
+	TrustedComment   string // Default is the time and, for files, the name.
+	UntrustedComment string // Default names the signing key.
+}
+
+// Option is a functional option for signing.
+type Option func(*Options)
+
+// WithTrustedComment sets the authenticated comment. It must be a
+// single line.
+func WithTrustedComment(c string) Option {
+	return func(o *Options) {
+		o.TrustedComment = c
+	}
+}
+
+// WithUntrustedComment sets the free text comment. It must be a single
+// line.
+func WithUntrustedComment(c string) Option {
+	return func(o *Options) {
+		o.UntrustedComment = c
+	}
+}
+
+// Sign signs data held in memory.
+func Sign(k *PrivateKey, data []byte, opts ...Option) (*Signature, error) {
+	return SignReader(k, bytes.NewReader(data), opts...)
+}
+
+// SignReader signs the content of r in a single streaming pass.
+func SignReader(k *PrivateKey, r io.Reader, opts ...Option) (*Signature, error) {
+	return signReader(k, r, "", opts)
+}
+
+// SignFile signs the file at path. The default trusted comment records
+// the time and the base name of the file, as minisign does.
+func SignFile(k *PrivateKey, path string, opts ...Option) (*Signature, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return signReader(k, f, filepath.Base(path), opts)
+}
+
+// signReader signs r, naming file in the default trusted comment.
+func signReader(k *PrivateKey, r io.Reader, file string, opts []Option) (*Signature, error) {
+	options := &Options{
+		TrustedComment:   fmt.Sprintf("timestamp:%d", time.Now().Unix()),
+		UntrustedComment: "signature from bsg secret key " + k.ID.String(),
+	}
+	if file != "" {
+		options.TrustedComment += "\tfile:" + file + "\thashed"
+	}
+	for _, opt := range opts {
+		opt(options)
+	}
+	if strings.ContainsAny(options.TrustedComment+options.UntrustedComment, "\r\n") {
+		return nil, fmt.Errorf("%w: comments must be a single line", ErrInvalidSignature)
+	}
+
+	digest, err := blake2b512(r)
+	if err != nil {
+		return nil, err
+	}
+	s := &Signature{
+		Algorithm:        algPrehashed,
+		KeyID:            k.ID,
+		UntrustedComment: options.UntrustedComment,
+		TrustedComment:   options.TrustedComment,
+	}
+	copy(s.Signature[:], ed25519.Sign(k.Key, digest))
+	copy(s.GlobalSignature[:], ed25519.Sign(k.Key, s.globalMessage()))
+	return s, nil
+}
+
+// globalMessage is what the global signature covers.
+func (s *Signature) globalMessage() []byte {
+	return append(append([]byte{}, s.Signature[:]...), s.TrustedComment...)
+}
+
+// Verify checks a signature over data held in memory.
+func Verify(p *PublicKey, s *Signature, data []byte) error {
+	return VerifyReader(p, s, bytes.NewReader(data))
+}
+
+// VerifyReader checks the signature over the content of r. The key
+// identifiers are compared first so that a signature from another key
+// is reported as ErrKeyIDMismatch rather than as a bad signature.
+func VerifyReader(p *PublicKey, s *Signature, r io.Reader) error {
+	if s.KeyID != p.ID {
+		return fmt.Errorf("%w: signed by %s, public key is %s",
+			ErrKeyIDMismatch, s.KeyID, p.ID)
+	}
+	var msg []byte
+	var err error
+	switch s.Algorithm {
+	case algPrehashed:
+		msg, err = blake2b512(r)
+	case algLegacy:
+		msg, err = io.ReadAll(r)
+	default:
+		return fmt.Errorf("%w: %q", ErrUnsupportedAlgorithm, s.Algorithm)
+	}
+	if err != nil {
+		return err
+	}
+	if !ed25519.Verify(p.Key, msg, s.Signature[:]) {
+		return ErrVerification
+	}
+	if !ed25519.Verify(p.Key, s.globalMessage(), s.GlobalSignature[:]) {
+		return ErrTrustedComment
+	}
+	return nil
+}
+
+// VerifyFile checks the signature over the file at path.
+func VerifyFile(p *PublicKey, s *Signature, path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return VerifyReader(p, s, f)
+}
+
+// Marshal encodes the signature in the four line minisign format.
+func (s *Signature) Marshal() []byte {
+	b := append([]byte(s.Algorithm), s.KeyID[:]...)
+	b = append(b, s.Signature[:]...)
+	var out bytes.Buffer
+	out.WriteString(untrustedPrefix + s.UntrustedComment + "\n")
+	out.WriteString(base64.StdEncoding.EncodeToString(b) + "\n")
+	out.WriteString(trustedPrefix + s.TrustedComment + "\n")
+	out.WriteString(base64.StdEncoding.EncodeToString(s.GlobalSignature[:]) + "\n")
+	return out.Bytes()
+}
+
+// ParseSignature decodes a minisign signature file.
+func ParseSignature(data []byte) (*Signature, error) {
+	lines := strings.Split(strings.TrimRight(string(data), "\r\n"), "\n")
+	for i := range lines {
+		lines[i] = strings.TrimRight(lines[i], "\r")
+	}
+	if len(lines) != 4 || !strings.HasPrefix(lines[0], untrustedPrefix) ||
+		!strings.HasPrefix(lines[2], trustedPrefix) {
+		return nil, fmt.Errorf("%w: expected 4 lines with comments", ErrInvalidSignature)
+	}
+	s := &Signature{
+		UntrustedComment: strings.TrimPrefix(lines[0], untrustedPrefix),
+		TrustedComment:   strings.TrimPrefix(lines[2], trustedPrefix),
+	}
+
+	b, err := base64.StdEncoding.DecodeString(lines[1])
+	if err != nil || len(b) != 2+KeyIDSize+ed25519.SignatureSize {
+		return nil, fmt.Errorf("%w: bad signature line", ErrInvalidSignature)
+	}
+	s.Algorithm = string(b[:2])
+	if s.Algorithm != algPrehashed && s.Algorithm != algLegacy {
+		return nil, fmt.Errorf("%w: %q", ErrUnsupportedAlgorithm, s.Algorithm)
+	}
+	copy(s.KeyID[:], b[2:])
+	copy(s.Signature[:], b[2+KeyIDSize:])
+
+	g, err := base64.StdEncoding.DecodeString(lines[3])
+	if err != nil || len(g) != ed25519.SignatureSize {
+		return nil, fmt.Errorf("%w: bad global signature line", ErrInvalidSignature)
+	}
+	copy(s.GlobalSignature[:], g)
+	return s, nil
+}
+
+// blake2b512 hashes the content of r.
+func blake2b512(r io.Reader) ([]byte, error) {
+	h, err := blake2b.New512(nil)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := io.Copy(h, r); err != nil {
+		return nil, err
+	}
+	return h.Sum(nil), nil
+}
@@ -0,0 +1,176 @@
+// sign_test.go - Test Program `sign` Package
+//
+//     ॐ भूर्भुवः स्वः
+//     तत्स॑वि॒तुर्वरे॑ण्यं॒
+//    भर्गो॑ दे॒वस्य॑ धीमहि।
+//   धियो॒ यो नः॑ प्रचो॒दया॑त्॥
+//
+//
+//  बी.स.जी - बोसजी के द्वारा रचित सुरक्षा एवं गोपनीयता हेतु तन्त्राक्ष्।
+// ================================================
+//
+// एक सुरक्षा एवं गोपनीयता केंद्रित तंत्राक्षों का संकलन।
+//
+// एक रचनात्मक भारतीय उत्पाद ।
+//
+// bsg - Boseji's Security and Privacy Utilities
+//
+// A collection of Security and Privacy utilities and some notes for help.
+//
+// This is **Golang** package collection as well as few utility
+// command line programs.
+//
+// Sources
+// -------
+// https://github.com/boseji/bsg
+//
+// License
+// -------
+//
+//   bsg - Boseji's Security and Privacy Utilities.
+//   Copyright (C) 2025-2026 by Abhijit Bose (aka. Boseji)
+//
+//   This program is free software: you can redistribute it and/or modify
+//   it under the terms of the GNU General Public License version 2 only
+//   as published by the Free Software Foundation.
+//
+//   This program is distributed in the hope that it will be useful,
+//   but WITHOUT ANY WARRANTY; without even the implied warranty of
+//   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+//
+//   You should have received a copy of the GNU General Public License
+//   along with this program. If not, see <https://www.gnu.org/licenses/>.
+//
+//  SPDX-License-Identifier: GPL-2.0-only
+//  Full Name: GNU General Public License v2.0 only
+//  Please visit <https://spdx.org/licenses/GPL-2.0-only.html> for details.
+//
+
+package sign_test
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/boseji/bsg/sign"
+)
+
+func TestSignVerify(t *testing.T) {
+	k, _ := sign.GenerateKey()
+	data := bytes.Repeat([]byte("release artifact "), 10000)
+	s, err := sign.Sign(k, data, sign.WithTrustedComment("version:1.2.3"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := sign.Verify(k.Public(), s, data); err != nil {
+		t.Fatalf("Verify: %v", err)
+	}
+
+	s2, err := sign.ParseSignature(s.Marshal())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *s2 != *s {
+		t.Fatalf("ParseSignature = %+v, want %+v", s2, s)
+	}
+	if err := sign.Verify(k.Public(), s2, data); err != nil {
+		t.Fatalf("Verify parsed: %v", err)
+	}
+
+	// The text form follows minisign line by line.
+	lines := strings.Split(string(s.Marshal()), "\n")
+	if len(lines) != 5 || lines[2] != "trusted comment: version:1.2.3" ||
+		!strings.HasPrefix(lines[0], "untrusted comment: ") {
+		t.Fatalf("signature text %q", lines)
+	}
+}
+
+func TestVerifyFailures(t *testing.T) {
+	k, _ := sign.GenerateKey()
+	other, _ := sign.GenerateKey()
+	data := []byte("payload")
+	s, _ := sign.Sign(k, data)
+
+	tampered := *s
+	tampered.TrustedComment = "timestamp:0"
+	badSig := *s
+	badSig.Signature[0] ^= 1
+	// A different key that claims the signer's identifier.
+	impostor := other.Public()
+	impostor.ID = k.ID
+
+	tests := []struct {
+		name string
+		pub  *sign.PublicKey
+		sig  *sign.Signature
+		data []byte
+		want error
+	}{
+		{"changed data", k.Public(), s, []byte("payloaD"), sign.ErrVerification},
+		{"changed trusted comment", k.Public(), &tampered, data, sign.ErrTrustedComment},
+		{"changed signature", k.Public(), &badSig, data, sign.ErrVerification},
+		{"other key", other.Public(), s, data, sign.ErrKeyIDMismatch},
+		{"same id other key", impostor, s, data, sign.ErrVerification},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := sign.Verify(tt.pub, tt.sig, tt.data); !errors.Is(err, tt.want) {
+				t.Fatalf("want %v, got %v", tt.want, err)
+			}
+		})
+	}
+
+	err := sign.Verify(other.Public(), s, data)
+	if !strings.Contains(err.Error(), k.ID.String()) ||
+		!strings.Contains(err.Error(), other.ID.String()) {
+		t.Errorf("mismatch error should name both keys: %v", err)
+	}
+}
+
+func TestSignFile(t *testing.T) {
+	k, _ := sign.GenerateKey()
+	path := filepath.Join(t.TempDir(), "btotp.tar.gz")
+	if err := os.WriteFile(path, []byte("archive"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	s, err := sign.SignFile(k, path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(s.TrustedComment, "\tfile:btotp.tar.gz\thashed") {
+		t.Fatalf("trusted comment %q", s.TrustedComment)
+	}
+	if err := sign.VerifyFile(k.Public(), s, path); err != nil {
+		t.Fatalf("VerifyFile: %v", err)
+	}
+	if _, err := sign.SignFile(k, path, sign.WithTrustedComment("a\nb")); !errors.Is(err, sign.ErrInvalidSignature) {
+		t.Fatalf("multi-line comment: %v", err)
+	}
+}
+
+func TestParseSignatureErrors(t *testing.T) {
+	k, _ := sign.GenerateKey()
+	s, _ := sign.Sign(k, []byte("x"))
+	good := string(s.Marshal())
+	lines := strings.Split(good, "\n")
+	bad := map[string]string{
+		"three lines":  strings.Join(lines[:3], "\n"),
+		"bad base64":   strings.Replace(good, lines[1], "!!!", 1),
+		"no trusted":   strings.Replace(good, "trusted comment: ", "comment: ", 1),
+		"short global": strings.Replace(good, lines[3], "AAAA", 1),
+		"unknown alg":  strings.Replace(good, lines[1], "Zm9v"+lines[1][4:], 1),
+	}
+	for name, in := range bad {
+		if _, err := sign.ParseSignature([]byte(in)); err == nil {
+			t.Errorf("%s: accepted", name)
+		}
+	}
+	// Windows line endings are accepted.
+	if _, err := sign.ParseSignature([]byte(strings.ReplaceAll(good, "\n", "\r\n"))); err != nil {
+		t.Errorf("CRLF: %v", err)
+	}
+}
@@ -62,14 +62,13 @@
 package totp
 
 import (
-	"crypto/hmac"
 	"crypto/sha1"
-	"encoding/base32"
 	"encoding/binary"
 	"fmt"
 	"hash"
-	"strings"
 	"time"
+
+	"github.com/boseji/bsg/gen"
 )
 
 // Options holds configuration parameters for TOTP generation.
@@ -108,6 +107,8 @@ func WithDigits(digits int) Option {
 
 // WithAlgorithm sets the hash algorithm used for HMAC.
 // For example, use sha1.New (default), sha256.New, or sha512.New.
+// Algorithms named in configuration can be looked up with
+// gen.ParseAlgorithm and passed in as its New field.
 func WithAlgorithm(alg func() hash.Hash) Option {
 	return func(opts *Options) {
 		opts.Algorithm = alg
@@ -138,27 +139,53 @@ func Generate(secret string, opts ...Option) (string, error) {
 		t = options.Time
 	}
 
-	// Normalize the secret: trim whitespace, convert to uppercase,
-	// and remove any '=' characters.
-	secret = strings.ToUpper(strings.TrimSpace(secret))
-	secret = strings.ReplaceAll(secret, "=", "")
+	// Decode the Base32 secret, ignoring white space, case and padding.
+	key, err := gen.DecodeBase32(secret)
+	if err != nil {
+		return "", fmt.Errorf("error decoding secret: %v", err)
+	}
+	defer clear(key)
+	return generate(key, t, options)
+}
+
+// GenerateSecret is Generate for a Base32-encoded secret held in a
+// gen.Secret. The decoded key only ever lives in locked memory and is
+// wiped before returning.
+func GenerateSecret(secret *gen.Secret, opts ...Option) (string, error) {
+	options := DefaultOptions()
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	// If no time provided, use current time.
+	t := options.Time
+	if t.IsZero() {
+		t = time.Now()
+	}
 
-	// Decode the Base32 secret using a decoder that expects no padding.
-	decoder := base32.StdEncoding.WithPadding(base32.NoPadding)
-	key, err := decoder.DecodeString(secret)
+	key, err := gen.DecodeBase32Secret(secret)
 	if err != nil {
 		return "", fmt.Errorf("error decoding secret: %v", err)
 	}
+	defer key.Destroy()
+	var code string
+	err = key.Use(func(k []byte) error {
+		code, err = generate(k, t, options)
+		return err
+	})
+	return code, err
+}
 
+// generate computes the code for a raw key at time t.
+func generate(key []byte, t time.Time, options Options) (string, error) {
 	// Calculate the time counter based on the provided time and period.
 	counter := uint64(t.Unix() / int64(options.Period))
 	var counterBytes [8]byte
 	binary.BigEndian.PutUint64(counterBytes[:], counter)
 
 	// Create an HMAC hash using the selected algorithm.
-	mac := hmac.New(options.Algorithm, key)
-	mac.Write(counterBytes[:])
-	hashResult := mac.Sum(nil)
+	hashResult := gen.HMAC(options.Algorithm, key, counterBytes[:])
+	defer clear(hashResult)
 
 	// Dynamic truncation per RFC 4226.
 	offset := hashResult[len(hashResult)-1] & 0x0F
@@ -186,13 +213,8 @@ func Generate(secret string, opts ...Option) (string, error) {
 // This intern is equivalent to calling Generate with default options.
 // This is included for backward compatibility with the original implementation.
 func GenerateTOTP(secret string) string {
-	// Normalize the secret: trim whitespace, convert to uppercase, and remove all '=' characters.
-	secret = strings.ToUpper(strings.TrimSpace(secret))
-	secret = strings.ReplaceAll(secret, "=", "")
-
-	// Create a Base32 decoder configured to expect no padding.
-	decoder := base32.StdEncoding.WithPadding(base32.NoPadding)
-	key, err := decoder.DecodeString(secret)
+	// Decode the Base32 secret, ignoring white space, case and padding.
+	key, err := gen.DecodeBase32(secret)
 	if err != nil {
 		// In a production app, you might want to handle the error differently.
 		panic(fmt.Sprintf("Error decoding secret '%s': %v", secret, err))
@@ -206,9 +228,7 @@ func GenerateTOTP(secret string) string {
 	binary.BigEndian.PutUint64(buf, timeStep)
 
 	// Create an HMAC-SHA1 hash from the key and time step
-	h := hmac.New(sha1.New, key)
-	h.Write(buf)
-	hash := h.Sum(nil)
+	hash := gen.HMACSHA1(key, buf)
 
 	// Dynamic truncation: use the last nibble of the hash as an offset
 	offset := hash[len(hash)-1] & 0x0F
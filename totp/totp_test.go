@@ -53,6 +53,8 @@ import (
 	"fmt"
 	"testing"
 	"time"
+
+	"github.com/boseji/bsg/gen"
 )
 
 // TestGenerate runs several test cases to verify the behavior of Generate.
@@ -121,6 +123,59 @@ func TestGenerate(t *testing.T) {
 	}
 }
 
+// TestGenerateLenientSecret checks that spaces, lower case and padding
+// in the secret do not change the code.
+func TestGenerateLenientSecret(t *testing.T) {
+	secret := " gezd gnbv gy3t qojq gezd gnbv gy3t qojq== "
+	otp, err := Generate(secret, WithTime(time.Unix(59, 0)))
+	if err != nil || otp != "287082" {
+		t.Errorf("Generate = %q, %v; expected %q", otp, err, "287082")
+	}
+}
+
+// TestGenerateAlgorithmByName checks that algorithms looked up by name in
+// the `gen` registry give the same codes as the hash constructors.
+func TestGenerateAlgorithmByName(t *testing.T) {
+	secret := "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+	tests := []struct {
+		name        string
+		expectedOTP string
+	}{
+		{"sha1", "287082"},
+		{"sha256", "247374"},
+		{"SHA-256", "247374"},
+	}
+	for _, tc := range tests {
+		alg, err := gen.ParseAlgorithm(tc.name)
+		if err != nil {
+			t.Fatalf("ParseAlgorithm(%q) failed: %v", tc.name, err)
+		}
+		otp, err := Generate(secret, WithTime(time.Unix(59, 0)), WithAlgorithm(alg.New))
+		if err != nil || otp != tc.expectedOTP {
+			t.Errorf("%s: Generate = %q, %v; expected %q", tc.name, otp, err, tc.expectedOTP)
+		}
+	}
+}
+
+// TestGenerateSecret checks that a secret held in a gen.Secret gives the
+// same codes as the string form.
+func TestGenerateSecret(t *testing.T) {
+	secret := gen.NewSecretString("GEZD GNBV GY3T QOJQ GEZD GNBV GY3T QOJQ")
+	defer secret.Destroy()
+
+	otp, err := GenerateSecret(secret, WithTime(time.Unix(59, 0)))
+	if err != nil || otp != "287082" {
+		t.Fatalf("GenerateSecret = %q, %v", otp, err)
+	}
+	otp, err = GenerateSecret(secret, WithTime(time.Unix(1111111109, 0)), WithDigits(8))
+	if err != nil || otp != "07081804" {
+		t.Fatalf("GenerateSecret 8 digits = %q, %v", otp, err)
+	}
+	if _, err := GenerateSecret(gen.NewSecretString("INVALIDSECRET!")); err == nil {
+		t.Fatal("Expected error for an invalid secret")
+	}
+}
+
 // ExampleGenerate demonstrates using Generate with default options and a custom time.
 func ExampleGenerate() {
 	// The Base32-encoded secret is that of "12345678901234567890".